@@ -60,10 +60,14 @@ func startServer(port int, mgoDb *mgo.Database, isDebug bool) {
 
 	router.Use(util.MgoDb(mgoDb))
 
+	router.GET("/category", admin.ReadCategory)
+
 	router.GET("/game", admin.ReadGame)
 	router.POST("/game", admin.CreateGame)
 	router.PATCH("/game/:id", admin.UpdateGame)
 	router.DELETE("/game/:id", admin.DeleteGame)
+	router.POST("/game/register", admin.RegisterGame)
+	router.GET("/game/live", admin.ReadLiveGames)
 
 	router.GET("/user/:id", admin.ReadUser)
 	router.POST("/user", admin.CreateUser)
@@ -74,5 +78,7 @@ func startServer(port int, mgoDb *mgo.Database, isDebug bool) {
 	router.PATCH("/game-user/:userId/:gameName", admin.CreateUpdateUserGame)
 	router.DELETE("/game-user/:userId/:gameName", admin.DeleteUserGame)
 
+	router.GET("/mistake/:user", admin.ReadMistakes)
+
 	router.Run(fmt.Sprintf(":%d", port))
 }
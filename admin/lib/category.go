@@ -0,0 +1,10 @@
+package admin
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/peteraba/d5/lib/german"
+)
+
+func ReadCategory(c *gin.Context) {
+	OkWithData(c, german.GetCategories())
+}
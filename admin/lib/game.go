@@ -1,7 +1,13 @@
 package admin
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/gin-gonic/gin"
+	"github.com/peteraba/d5/lib/play"
 	"github.com/peteraba/d5/lib/util"
 	"gopkg.in/mgo.v2"
 	"gopkg.in/mgo.v2/bson"
@@ -11,12 +17,92 @@ const (
 	gameColl = "game"
 )
 
+// Game is a game known by admin, games registering themselves also store their description
 type Game struct {
-	Id       bson.ObjectId `bson:"_id,omitempty" json:"_id,omitempty"`
-	Name     string        `form:"name" json:"name" binding:"required"`
-	Route    string        `form:"route" json:"route" binding:"required"`
-	Url      string        `form:"url" json:"url" binding:"required"`
-	IsSystem bool          `form:"is-system" json:"isSystem" bson:"isSystem,omitempty"`
+	Id            bson.ObjectId `bson:"_id,omitempty" json:"_id,omitempty"`
+	Name          string        `form:"name" json:"name" binding:"required"`
+	Route         string        `form:"route" json:"route" binding:"required"`
+	Url           string        `form:"url" json:"url" binding:"required"`
+	IsSystem      bool          `form:"is-system" json:"isSystem" bson:"isSystem,omitempty"`
+	Description   string        `form:"-" json:"description,omitempty" bson:"description,omitempty"`
+	Categories    []string      `form:"-" json:"categories,omitempty" bson:"categories,omitempty"`
+	RequiresWords bool          `form:"-" json:"requiresWords" bson:"requiresWords,omitempty"`
+	Options       []play.Option `form:"-" json:"options,omitempty" bson:"options,omitempty"`
+	Version       string        `form:"-" json:"version,omitempty" bson:"version,omitempty"`
+	RegisteredAt  *time.Time    `form:"-" json:"registeredAt,omitempty" bson:"registeredAt,omitempty"`
+}
+
+// GetMeta returns the description of a game as registered
+func (g Game) GetMeta() play.Meta {
+	return play.Meta{
+		Name:          g.Name,
+		Description:   g.Description,
+		Categories:    g.Categories,
+		RequiresWords: g.RequiresWords,
+		Options:       g.Options,
+		Version:       g.Version,
+		Url:           g.Url,
+	}
+}
+
+// FindGames returns all the games known
+func FindGames(mgoDb *mgo.Database) ([]Game, error) {
+	var result []Game
+
+	err := mgoDb.C(gameColl).Find(bson.M{}).All(&result)
+
+	return result, err
+}
+
+// FindGameByName returns the game with the given name
+func FindGameByName(mgoDb *mgo.Database, name string) (Game, error) {
+	var result []Game
+
+	err := mgoDb.C(gameColl).Find(bson.M{"name": name}).All(&result)
+	if err != nil {
+		return Game{}, err
+	}
+
+	if len(result) < 1 {
+		return Game{}, errors.New(fmt.Sprintf("Game not found: %s", name))
+	}
+
+	return result[0], nil
+}
+
+// RegisterGame stores or updates a game described by itself, games register on startup
+func RegisterGame(c *gin.Context) {
+	var meta play.Meta
+
+	if c.BindJSON(&meta) != nil || meta.Name == "" || meta.Url == "" {
+		BadRequest(c)
+
+		return
+	}
+
+	mgoDb := c.MustGet("mgoDb").(*mgo.Database)
+
+	mgoCollection := mgoDb.C(gameColl)
+
+	_, err := mgoCollection.Upsert(bson.M{"name": meta.Name}, bson.M{
+		"$set": bson.M{
+			"url":           meta.Url,
+			"description":   meta.Description,
+			"categories":    meta.Categories,
+			"requiresWords": meta.RequiresWords,
+			"options":       meta.Options,
+			"version":       meta.Version,
+			"registeredAt":  time.Now(),
+		},
+		"$setOnInsert": bson.M{"route": strings.ToLower(meta.Name)},
+	})
+	if err != nil {
+		InternalServerError(c, err, "Registering game failed")
+
+		return
+	}
+
+	Ok(c)
 }
 
 func CreateGame(c *gin.Context) {
@@ -0,0 +1,126 @@
+package admin
+
+import (
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/peteraba/d5/lib/german"
+	"github.com/peteraba/d5/lib/mongo"
+	"github.com/peteraba/d5/lib/play"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// LiveGame is a game with its health and the users having the words needed to play it
+type LiveGame struct {
+	Game   Game        `json:"game"`
+	IsLive bool        `json:"isLive"`
+	Health play.Health `json:"health"`
+	Users  []string    `json:"users"`
+}
+
+// FindUsers returns all the users known
+func FindUsers(mgoDb *mgo.Database) ([]User, error) {
+	var result []User
+
+	err := mgoDb.C(userColl).Find(bson.M{}).All(&result)
+
+	return result, err
+}
+
+// CountWords returns the number of words of a user by category, aliases are counted as their category
+func CountWords(mgoDb *mgo.Database, username string) (map[string]int, error) {
+	var (
+		rows []struct {
+			Category string `bson:"_id"`
+			Count    int    `bson:"count"`
+		}
+		counts = map[string]int{}
+	)
+
+	pipeline := []bson.M{
+		{"$match": bson.M{"word.user": username}},
+		{"$group": bson.M{"_id": "$word.category", "count": bson.M{"$sum": 1}}},
+	}
+
+	err := mgoDb.C(mongo.ParseDataCollection()).Pipe(pipeline).All(&rows)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		counts[german.CanonicalCategory(row.Category)] += row.Count
+	}
+
+	return counts, nil
+}
+
+// ReadLiveGames lists the games known with their health and the users who can play them based on their dictionary
+func ReadLiveGames(c *gin.Context) {
+	mgoDb := c.MustGet("mgoDb").(*mgo.Database)
+
+	games, err := FindGames(mgoDb)
+	if err != nil {
+		InternalServerError(c, err, "Listing games failed")
+
+		return
+	}
+
+	users, err := FindUsers(mgoDb)
+	if err != nil {
+		InternalServerError(c, err, "Listing users failed")
+
+		return
+	}
+
+	counts := map[string]map[string]int{}
+	for _, user := range users {
+		counts[user.Username], err = CountWords(mgoDb, user.Username)
+		if err != nil {
+			InternalServerError(c, err, "Counting words failed")
+
+			return
+		}
+	}
+
+	result := []LiveGame{}
+	for i, health := range checkHealths(games) {
+		liveGame := LiveGame{Game: games[i], IsLive: health.Status == play.StatusOk, Health: health, Users: []string{}}
+
+		for _, user := range users {
+			if games[i].GetMeta().IsPlayable(counts[user.Username]) {
+				liveGame.Users = append(liveGame.Users, user.Username)
+			}
+		}
+
+		result = append(result, liveGame)
+	}
+
+	OkWithData(c, result)
+}
+
+// checkHealths asks all games for their health at once, games without a url are down
+func checkHealths(games []Game) []play.Health {
+	var wg sync.WaitGroup
+
+	healths := make([]play.Health, len(games))
+
+	for i, game := range games {
+		if game.Url == "" {
+			healths[i] = play.Health{Name: game.Name, Status: play.StatusDown, Error: "Game has no url."}
+
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, gameUrl string) {
+			defer wg.Done()
+
+			healths[i] = play.CheckHealth(gameUrl)
+		}(i, game.Url)
+	}
+
+	wg.Wait()
+
+	return healths
+}
@@ -0,0 +1,34 @@
+package admin
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/peteraba/d5/lib/mistake"
+	"gopkg.in/mgo.v2"
+)
+
+// ReadMistakes lists the mistake journal of a user grouped by pattern, the game parameter narrows it to a game
+func ReadMistakes(c *gin.Context) {
+	var (
+		err      error
+		user     string
+		mistakes []mistake.Mistake
+	)
+
+	user = c.Param("user")
+	if user == "" {
+		BadRequest(c)
+
+		return
+	}
+
+	mgoDb := c.MustGet("mgoDb").(*mgo.Database)
+
+	mistakes, err = mistake.Find(mistake.GetCollection(mgoDb), user, c.Query("game"))
+	if err != nil {
+		InternalServerError(c, err, "Finding mistakes failed")
+
+		return
+	}
+
+	OkWithData(c, mistake.GroupMistakes(mistakes))
+}
@@ -1,7 +1,12 @@
 package admin
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/gin-gonic/gin"
+	"github.com/peteraba/d5/lib/german/entity"
+	"github.com/peteraba/d5/lib/play"
 	"github.com/peteraba/d5/lib/util"
 	"gopkg.in/mgo.v2"
 	"gopkg.in/mgo.v2/bson"
@@ -14,13 +19,56 @@ const (
 type User struct {
 	Username string              `json:"username"`
 	MaxWords int                 `json:"maxWords"`
+	Language string              `json:"language"`
 	Games    map[string]UserGame `json:"userGames"`
 }
 
+// GetChoices returns the games the user can play with their weights, games unknown or without a url are skipped
+func (u User) GetChoices(games []Game) []play.Choice {
+	choices := []play.Choice{}
+
+	for _, game := range games {
+		userGame, ok := u.Games[game.Name]
+		if !ok || game.Url == "" {
+			continue
+		}
+
+		choices = append(choices, play.Choice{Name: game.Name, Url: game.Url, Weight: userGame.Weight})
+	}
+
+	return choices
+}
+
+// FindUserByName returns the user with the given username
+func FindUserByName(mgoDb *mgo.Database, username string) (User, error) {
+	var result []User
+
+	err := mgoDb.C(userColl).Find(bson.M{"username": username}).All(&result)
+	if err != nil {
+		return User{}, err
+	}
+
+	if len(result) < 1 {
+		return User{}, errors.New(fmt.Sprintf("User not found: %s", username))
+	}
+
+	return result[0], nil
+}
+
 type UserForm struct {
 	Id       string `form:"id"`
 	Username string `form:"username"`
 	MaxWords int    `form:"max-words"`
+	Language string `form:"language"`
+}
+
+// getLanguage returns the language code of the preferred translation language, English if none is given
+func (f UserForm) getLanguage() (string, bool) {
+	if f.Language == "" {
+		return entity.DefaultLanguage, true
+	}
+
+	return entity.NewLanguage(f.Language)
 }
 
 func CreateUser(c *gin.Context) {
@@ -28,6 +76,8 @@ func CreateUser(c *gin.Context) {
 		err      error
 		user     User
 		userForm UserForm
+		lang     string
+		ok       bool
 	)
 
 	mgoDb := c.MustGet("mgoDb").(*mgo.Database)
@@ -40,7 +90,13 @@ func CreateUser(c *gin.Context) {
 		return
 	}
 
-	user = User{userForm.Username, userForm.MaxWords, make(map[string]UserGame)}
+	if lang, ok = userForm.getLanguage(); !ok {
+		BadRequest(c)
+
+		return
+	}
+
+	user = User{userForm.Username, userForm.MaxWords, lang, make(map[string]UserGame)}
 
 	err = mgoCollection.Insert(user)
 	if err != nil {
@@ -95,6 +151,16 @@ func UpdateUser(c *gin.Context) {
 	if c.PostForm("max-words") != "" {
 		user.MaxWords = userForm.MaxWords
 	}
+	if c.PostForm("language") != "" {
+		lang, ok := userForm.getLanguage()
+		if !ok {
+			BadRequest(c)
+
+			return
+		}
+
+		user.Language = lang
+	}
 
 	err = mgoCollection.UpdateId(objectId, user)
 	if err != nil {
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"time"
+
+	"github.com/peteraba/d5/lib/backtest"
+	"github.com/peteraba/d5/lib/util"
+)
+
+const name = "backtester"
+const version = "0.1"
+const usage = `
+Backtester replays the scores of exported words against word-selection strategies.
+
+Words are read from the file given or from standard input, either as a JSON array or one JSON document per line, as
+exported by mongoexport or the finder. Only the scores of the words are used.
+
+Usage:
+  backtester [--debug] [--strategies=<s>] [--retention=<f>] [--scale=<f>] [--end=<d>] [<file>]
+  backtester -h | --help
+  backtester -v | --version
+
+Options:
+  -d, --debug            indent the report
+  -s, --strategies=<s>   comma separated list of strategies: progress, sm2, fsrs [default: progress,sm2,fsrs]
+  -r, --retention=<f>    probability of recall at which a word becomes due [default: 0.9]
+  -c, --scale=<f>        progress score difference changing the odds of recall e-fold (progress only) [default: 1]
+  -e, --end=<d>          end of the replay as YYYY-MM-DD, the latest score by default
+  -v, --version          show version information
+  -h, --help             show help information
+
+Reported metrics:
+  - predictions  scores predicted from the scores before them
+  - accuracy     share of predictions of at least 0.5 given exactly for recalled words (result of 5 or more)
+  - logLoss      average log-loss of the predictions
+  - reviews      reviews of new and due words
+  - early        reviews the strategy would have skipped
+  - overdue      words due at the end of the replay
+`
+
+/**
+ * MAIN
+ */
+
+func main() {
+	cliArguments := util.GetCliArguments(usage, name, version)
+	_, _, isDebug := util.GetServerOptions(cliArguments)
+
+	result, err := cliHandler(cliArguments, isDebug)
+
+	util.LogFatalErr(err, true)
+
+	fmt.Print(result)
+}
+
+/**
+ * CLI
+ */
+
+func cliHandler(cliArguments map[string]interface{}, isDebug bool) (string, error) {
+	rawStrategies, _ := cliArguments["--strategies"].(string)
+	rawRetention, _ := cliArguments["--retention"].(string)
+	rawScale, _ := cliArguments["--scale"].(string)
+	rawEnd, _ := cliArguments["--end"].(string)
+	fileName, _ := cliArguments["<file>"].(string)
+
+	retention, err := strconv.ParseFloat(rawRetention, 64)
+	if err != nil || retention <= 0 || retention >= 1 {
+		return "", errors.New("Retention must be a number between 0 and 1")
+	}
+
+	scale, err := strconv.ParseFloat(rawScale, 64)
+	if err != nil || scale <= 0 {
+		return "", errors.New("Scale must be a positive number")
+	}
+
+	input, err := readInput(fileName)
+	if err != nil {
+		return "", err
+	}
+
+	histories, err := backtest.ParseHistories(input)
+	if err != nil {
+		return "", err
+	}
+
+	end := backtest.GetLastReview(histories)
+	if rawEnd != "" {
+		end, err = time.Parse("2006-01-02", rawEnd)
+		if err != nil {
+			return "", errors.New(fmt.Sprintf("Invalid end date: %s", rawEnd))
+		}
+	}
+
+	reports := []backtest.Report{}
+	for _, strategyName := range util.TrimSplit(rawStrategies, ",") {
+		strategy, err := backtest.GetStrategy(strategyName, scale)
+		if err != nil {
+			return "", err
+		}
+
+		reports = append(reports, backtest.Run(strategyName, strategy, histories, retention, end))
+	}
+
+	return util.DataToJson(reports, isDebug)
+}
+
+func readInput(fileName string) ([]byte, error) {
+	if fileName == "" {
+		return util.ReadStdInput()
+	}
+
+	return ioutil.ReadFile(fileName)
+}
@@ -0,0 +1,254 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"math/rand"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/peteraba/d5/game/articlecase/lib"
+	game "github.com/peteraba/d5/game/lib"
+	"github.com/peteraba/d5/lib/german/entity"
+	"github.com/peteraba/d5/lib/german/grade"
+	"github.com/peteraba/d5/lib/play"
+	"github.com/peteraba/d5/lib/util"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+const name = "ArticleCase"
+const version = "0.1"
+const defaultPort = "10510"
+
+const categoryPreposition = "prep"
+
+type ArticleCase struct{}
+
+/**
+ * MAIN
+ */
+
+func main() {
+	gameServer := ArticleCase{}
+	game.Main(name, version, defaultPort, gameServer)
+}
+
+// GetMeta describes the game for admin
+func (a ArticleCase) GetMeta() play.Meta {
+	return play.Meta{
+		Name:          name,
+		Description:   "Case of article game: the definite or indefinite article of a noun in a case, named or given by a preposition",
+		Categories:    []string{entity.CategoryNoun},
+		RequiresWords: true,
+		Version:       version,
+	}
+}
+
+/**
+ * DOMAIN
+ */
+
+func (a ArticleCase) MakeGameHandle(finderUrl string, mgoCollection *mgo.Collection, debug bool) func(c *gin.Context) {
+	return func(c *gin.Context) {
+		var (
+			noun        *entity.Noun
+			preposition entity.Word
+			query       = bson.M{}
+			gameAnswer  = game.GameAnswer{}
+		)
+
+		lang := game.GetLanguage(c)
+
+		query["word.category"] = entity.CategoryNoun
+		query["word.user"] = c.Param("user")
+
+		dictionary, returnCode, err := game.FetchRemediatedDictionary(c, finderUrl, mgoCollection, name, query, 1)
+		if err != nil {
+			c.JSON(returnCode, fmt.Sprint(err))
+
+			return
+		}
+
+		if len(dictionary.Nouns) > 0 {
+			noun = &dictionary.Nouns[0]
+		}
+
+		if noun == nil || len(noun.Articles) == 0 {
+			gameAnswer.Error = "No nouns found"
+			gameAnswer.SetDebugQuery(debug, &query, &dictionary, nil, nil)
+
+			c.JSON(200, gameAnswer)
+
+			return
+		}
+
+		isPlural, nounCase, isDefinite := a.getRandomPieces(noun)
+		wordIds := []string{noun.GetId().Hex()}
+
+		if rand.Int31n(2) == 1 {
+			preposition = a.fetchPreposition(finderUrl, c.Param("user"))
+		}
+
+		if preposition != nil {
+			nounCase, _ = lib.GetPrepositionCase(preposition.GetGerman())
+			wordIds = append(wordIds, preposition.GetId().Hex())
+		}
+
+		question, right := a.getQuestion(noun, preposition, isPlural, nounCase, isDefinite)
+
+		gameAnswer.Question = question
+		gameAnswer.Id = util.GenerateUid()
+
+		gameAnswer.SetDebugQuery(debug, &query, nil, noun, &bson.M{"plural": isPlural, "case": nounCase, "definite": isDefinite})
+		gameAnswer.SetDebugResult(debug, right, lang, noun.GetMeanings(lang))
+
+		err = game.SaveAnswer(gameAnswer.GetId(), a.getQuestionType(preposition, isPlural, nounCase, isDefinite), wordIds, right, mgoCollection)
+		if err != nil {
+			gameAnswer.Error = fmt.Sprint(err)
+		}
+
+		c.JSON(200, gameAnswer)
+	}
+}
+
+// getQuestionType describes the question asked, e.g. "preposition dative plural definite"
+func (a ArticleCase) getQuestionType(preposition entity.Word, isPlural bool, nounCase entity.Case, isDefinite bool) string {
+	var (
+		number  = "singular"
+		article = "definite"
+	)
+
+	if isPlural {
+		number = "plural"
+	}
+
+	if !isDefinite {
+		article = "indefinite"
+	}
+
+	if preposition == nil {
+		return fmt.Sprintf("%s %s %s", entity.GetCaseName(nounCase), number, article)
+	}
+
+	return fmt.Sprintf("preposition %s %s %s", entity.GetCaseName(nounCase), number, article)
+}
+
+// getRandomPieces chooses number, case and article type, the indefinite article has no plural so plurals are always asked with the definite one
+func (a ArticleCase) getRandomPieces(noun *entity.Noun) (bool, entity.Case, bool) {
+	var (
+		isPlural   bool
+		nounCase   = entity.Cases[rand.Intn(len(entity.Cases))]
+		isDefinite = rand.Int31n(2) == 1
+	)
+
+	if noun.IsPluralOnly {
+		isPlural = true
+	} else if len(noun.GetPlurals()) > 0 {
+		isPlural = rand.Int31n(2) == 1
+	}
+
+	if isPlural {
+		isDefinite = true
+	}
+
+	return isPlural, nounCase, isDefinite
+}
+
+// fetchPreposition returns one of the user's prepositions with a fixed case or nil if there is none
+func (a ArticleCase) fetchPreposition(finderUrl, user string) entity.Word {
+	query := bson.M{}
+
+	query["word.category"] = categoryPreposition
+	query["word.user"] = user
+	query["word.german"] = bson.M{"$in": lib.GetPrepositions()}
+
+	words, _, err := game.FetchWords(finderUrl, query, 1)
+	if err != nil {
+		log.Printf("Fetching prepositions failed: %v\n", err)
+
+		return nil
+	}
+
+	if len(words) == 0 || words[0] == nil {
+		return nil
+	}
+
+	if _, ok := lib.GetPrepositionCase(words[0].GetGerman()); !ok {
+		return nil
+	}
+
+	return words[0]
+}
+
+// getQuestion asks for the article either for a given case or following a preposition, the article alone and the whole phrase are both accepted
+func (a ArticleCase) getQuestion(noun *entity.Noun, preposition entity.Word, isPlural bool, nounCase entity.Case, isDefinite bool) (string, []string) {
+	var (
+		number   = "singular"
+		article  = "definite"
+		right    = []string{}
+		articles = []string{}
+		phrases  []string
+		word     string
+	)
+
+	if isPlural {
+		number = "plural"
+	}
+
+	if !isDefinite {
+		article = "indefinite"
+	}
+
+	for _, nounArticle := range noun.Articles {
+		if isDefinite {
+			word = entity.DefiniteArticle("der", nounArticle, isPlural, nounCase)
+		} else {
+			word = entity.IndefiniteArticle("ein", nounArticle, isPlural, nounCase)
+		}
+
+		if !util.StringIn(word, articles) {
+			articles = append(articles, word)
+		}
+	}
+
+	if isDefinite {
+		phrases = noun.DeclineWithArticle("der", true, isPlural, nounCase)
+	} else {
+		phrases = noun.DeclineWithArticle("ein", false, isPlural, nounCase)
+	}
+
+	right = append(right, articles...)
+	right = append(right, phrases...)
+
+	if preposition == nil {
+		return fmt.Sprintf("What's the %s article of '%s' in %s %s?", article, noun.GetGerman(), entity.GetCaseName(nounCase), number), right
+	}
+
+	forms := noun.Decline(isPlural, nounCase)
+	form := noun.GetGerman()
+	if len(forms) > 0 {
+		form = forms[0]
+	}
+
+	for _, word := range articles {
+		if contraction, ok := lib.GetContraction(preposition.GetGerman(), word); ok {
+			right = append(right, contraction)
+		}
+	}
+
+	for _, phrase := range phrases {
+		right = append(right, strings.Trim(preposition.GetGerman()+" "+phrase, " "))
+	}
+
+	return fmt.Sprintf("Fill in the %s article: '%s ___ %s' (%s)", article, preposition.GetGerman(), form, number), right
+}
+
+func (a ArticleCase) MakeCheckAnswerHandle(finderUrl, scorerUrl string, mgoCollection *mgo.Collection, debug bool) func(c *gin.Context) {
+	return game.MakeCheckAnswerHandle(name, finderUrl, scorerUrl, mgoCollection, a.gradeAnswer)
+}
+
+// gradeAnswer grades the article typed against the declined articles asked
+func (a ArticleCase) gradeAnswer(tracker game.Tracker, answer string, words []entity.Word) (game.Answer, error) {
+	return game.Answer{Answer: answer, Score: grade.GradeStrict(answer, tracker.Right), Right: tracker.Right}, nil
+}
@@ -0,0 +1,61 @@
+package lib
+
+import "github.com/peteraba/d5/lib/german/entity"
+
+// prepositionCases lists prepositions always followed by the same case, two-way prepositions are left out as their case depends on the meaning
+var prepositionCases = map[string]entity.Case{
+	"durch":     entity.CaseAcusative,
+	"für":       entity.CaseAcusative,
+	"gegen":     entity.CaseAcusative,
+	"ohne":      entity.CaseAcusative,
+	"um":        entity.CaseAcusative,
+	"aus":       entity.CaseDative,
+	"außer":     entity.CaseDative,
+	"bei":       entity.CaseDative,
+	"gegenüber": entity.CaseDative,
+	"mit":       entity.CaseDative,
+	"nach":      entity.CaseDative,
+	"seit":      entity.CaseDative,
+	"von":       entity.CaseDative,
+	"zu":        entity.CaseDative,
+	"anstatt":   entity.CaseGenitive,
+	"außerhalb": entity.CaseGenitive,
+	"innerhalb": entity.CaseGenitive,
+	"statt":     entity.CaseGenitive,
+	"trotz":     entity.CaseGenitive,
+	"während":   entity.CaseGenitive,
+	"wegen":     entity.CaseGenitive,
+}
+
+// contractions lists the usual contractions of prepositions and definite articles
+var contractions = map[string]string{
+	"bei dem": "beim",
+	"von dem": "vom",
+	"zu dem":  "zum",
+	"zu der":  "zur",
+}
+
+// GetPrepositions returns the prepositions with a fixed case
+func GetPrepositions() []string {
+	result := []string{}
+
+	for preposition := range prepositionCases {
+		result = append(result, preposition)
+	}
+
+	return result
+}
+
+// GetPrepositionCase returns the case a preposition is followed by, false for unknown and two-way prepositions
+func GetPrepositionCase(preposition string) (entity.Case, bool) {
+	nounCase, ok := prepositionCases[preposition]
+
+	return nounCase, ok
+}
+
+// GetContraction returns the contracted form of a preposition and an article, e.g. "zum" for "zu dem"
+func GetContraction(preposition, article string) (string, bool) {
+	contraction, ok := contractions[preposition+" "+article]
+
+	return contraction, ok
+}
@@ -0,0 +1,185 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	game "github.com/peteraba/d5/game/lib"
+	"github.com/peteraba/d5/lib/german/entity"
+	"github.com/peteraba/d5/lib/german/grade"
+	"github.com/peteraba/d5/lib/play"
+	"github.com/peteraba/d5/lib/util"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+const name = "Auxiliary"
+const version = "0.1"
+const defaultPort = "10490"
+
+const (
+	explanationHaben = "Most verbs form their perfect tense with haben: verbs with an accusative object, reflexive verbs, modal verbs and verbs describing a state or an activity (e.g. ich habe gearbeitet)."
+	explanationSein  = "Verbs expressing motion from one place to another or a change of state take sein if they have no accusative object (e.g. ich bin gegangen, er ist eingeschlafen), so do sein, bleiben and werden."
+	explanationBoth  = "Verbs of motion take sein when the movement itself is meant and haben when they are used with an accusative object (e.g. ich bin nach Berlin gefahren, ich habe das Auto gefahren)."
+)
+
+// Accepted answers, either the number of the option or the auxiliary typed
+var (
+	answersHaben = []string{"1", "haben"}
+	answersSein  = []string{"2", "sein"}
+	answersBoth  = []string{"3", "both", "beide", "haben/sein", "sein/haben"}
+)
+
+type Auxiliary struct{}
+
+/**
+ * MAIN
+ */
+
+func main() {
+	gameServer := Auxiliary{}
+	game.Main(name, version, defaultPort, gameServer)
+}
+
+// GetMeta describes the game for admin
+func (a Auxiliary) GetMeta() play.Meta {
+	return play.Meta{
+		Name:          name,
+		Description:   "Perfect tense auxiliary game: haben, sein or both",
+		Categories:    []string{entity.CategoryVerb},
+		RequiresWords: true,
+		Version:       version,
+	}
+}
+
+/**
+ * DOMAIN
+ */
+
+func (a Auxiliary) MakeGameHandle(finderUrl string, mgoCollection *mgo.Collection, debug bool) func(c *gin.Context) {
+	return func(c *gin.Context) {
+		var (
+			verb       *entity.Verb
+			query      = bson.M{}
+			gameOption = game.GameOption{}
+		)
+
+		lang := game.GetLanguage(c)
+
+		query["word.category"] = entity.CategoryVerb
+		query["word.user"] = c.Param("user")
+		query["auxiliary.0"] = bson.M{"$exists": true}
+
+		dictionary, returnCode, err := game.FetchRemediatedDictionary(c, finderUrl, mgoCollection, name, query, 1)
+		if err != nil {
+			c.JSON(returnCode, fmt.Sprint(err))
+
+			return
+		}
+
+		if len(dictionary.Verbs) > 0 && len(dictionary.Verbs[0].Auxiliary) > 0 {
+			verb = &dictionary.Verbs[0]
+		}
+
+		if verb == nil {
+			gameOption.Error = "No verbs found"
+			gameOption.SetDebugQuery(debug, &query, &dictionary, nil, nil)
+
+			c.JSON(200, gameOption)
+
+			return
+		}
+
+		right := a.getRight(verb)
+
+		gameOption.Question = fmt.Sprintf("Which auxiliary does '%s' take in perfect tense?", verb.GetGerman())
+		gameOption.Option1 = "haben"
+		gameOption.Option2 = "sein"
+		gameOption.Option3 = "both"
+		gameOption.Id = util.GenerateUid()
+
+		gameOption.SetDebugQuery(debug, &query, nil, verb, nil)
+		gameOption.SetDebugResult(debug, right, lang, verb.GetMeanings(lang))
+
+		err = game.SaveAnswer(gameOption.GetId(), "auxiliary", []string{verb.GetId().Hex()}, right, mgoCollection)
+		if err != nil {
+			gameOption.Error = fmt.Sprint(err)
+		}
+
+		c.JSON(200, gameOption)
+	}
+}
+
+func (a Auxiliary) getRight(verb *entity.Verb) []string {
+	hasHaben, hasSein := a.getAuxiliaries(verb)
+
+	if hasHaben && hasSein {
+		return answersBoth
+	}
+
+	if hasSein {
+		return answersSein
+	}
+
+	return answersHaben
+}
+
+func (a Auxiliary) getAuxiliaries(verb *entity.Verb) (bool, bool) {
+	var hasHaben, hasSein bool
+
+	for _, auxiliary := range verb.Auxiliary {
+		switch auxiliary {
+		case entity.Haben:
+			hasHaben = true
+			break
+		case entity.Sein:
+			hasSein = true
+			break
+		}
+	}
+
+	return hasHaben, hasSein
+}
+
+// getExplanation explains the rule of choosing the auxiliary of the verbs asked
+func (a Auxiliary) getExplanation(words []entity.Word) string {
+	for _, word := range words {
+		verb, ok := word.(*entity.Verb)
+		if !ok {
+			continue
+		}
+
+		hasHaben, hasSein := a.getAuxiliaries(verb)
+
+		if hasHaben && hasSein {
+			return explanationBoth
+		}
+
+		if hasSein {
+			return explanationSein
+		}
+
+		return explanationHaben
+	}
+
+	return ""
+}
+
+func (a Auxiliary) MakeCheckAnswerHandle(finderUrl, scorerUrl string, mgoCollection *mgo.Collection, debug bool) func(c *gin.Context) {
+	return game.MakeCheckAnswerHandle(name, finderUrl, scorerUrl, mgoCollection, a.gradeAnswer)
+}
+
+// gradeAnswer grades the auxiliary chosen, the rule of the verb asked is explained
+func (a Auxiliary) gradeAnswer(tracker game.Tracker, answer string, words []entity.Word) (game.Answer, error) {
+	if len(tracker.Right) < 2 {
+		return game.Answer{}, errors.New("Invalid answer stored.")
+	}
+
+	return game.Answer{
+		Answer:      answer,
+		Score:       grade.GradeStrict(answer, tracker.Right),
+		Right:       tracker.Right[1:],
+		Explanation: a.getExplanation(words),
+	}, nil
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"math/rand"
+	"os"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/peteraba/d5/game/cloze/lib"
+	game "github.com/peteraba/d5/game/lib"
+	"github.com/peteraba/d5/lib/german/entity"
+	"github.com/peteraba/d5/lib/german/grade"
+	"github.com/peteraba/d5/lib/play"
+	"github.com/peteraba/d5/lib/util"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+const name = "Cloze"
+const version = "0.1"
+const defaultPort = "10540"
+
+const (
+	// candidateCount is the number of words fetched to find one with a usable sentence
+	candidateCount = 10
+	// corpusLimit is the number of corpus sentences collected for a word
+	corpusLimit = 5
+	// scoreWrongForm is given for another form of the right word
+	scoreWrongForm = grade.ScoreTwoTypos
+)
+
+// Sources of the sentences, used as question type
+const (
+	sourceExample = "example"
+	sourceCorpus  = "corpus"
+)
+
+type Cloze struct{}
+
+/**
+ * MAIN
+ */
+
+func main() {
+	gameServer := Cloze{}
+	game.Main(name, version, defaultPort, gameServer)
+}
+
+// GetMeta describes the game for admin
+func (cl Cloze) GetMeta() play.Meta {
+	return play.Meta{
+		Name:          name,
+		Description:   "Fill-in-the-gap game: a form of a noun, verb or adjective missing from one of its example sentences",
+		RequiresWords: true,
+		Version:       version,
+	}
+}
+
+/**
+ * DOMAIN
+ */
+
+func (cl Cloze) MakeGameHandle(finderUrl string, mgoCollection *mgo.Collection, debug bool) func(c *gin.Context) {
+	corpus := cl.loadCorpus()
+
+	return func(c *gin.Context) {
+		var (
+			word       entity.Word
+			cloze      entity.Cloze
+			source     string
+			query      = bson.M{}
+			gameAnswer = game.GameAnswer{}
+		)
+
+		lang := game.GetLanguage(c)
+
+		query["word.category"] = bson.M{"$in": []string{entity.CategoryNoun, entity.CategoryVerb, entity.CategoryAdjective}}
+		query["word.user"] = c.Param("user")
+		if len(corpus) == 0 {
+			query["word.examples.0"] = bson.M{"$exists": true}
+		}
+
+		words, returnCode, err := game.FetchRemediatedWords(c, finderUrl, mgoCollection, name, query, candidateCount)
+		if err != nil && returnCode != 204 {
+			c.JSON(returnCode, fmt.Sprint(err))
+
+			return
+		}
+
+		for _, candidate := range words {
+			if candidate == nil {
+				continue
+			}
+
+			source = sourceExample
+			clozes := entity.GetClozes(candidate)
+			if len(clozes) == 0 {
+				source = sourceCorpus
+				clozes = lib.FindClozes(corpus, candidate, corpusLimit)
+			}
+
+			if len(clozes) > 0 {
+				word, cloze = candidate, clozes[rand.Intn(len(clozes))]
+				break
+			}
+		}
+
+		if word == nil {
+			gameAnswer.Error = "No sentences found"
+			gameAnswer.SetDebugQuery(debug, &query, nil, nil, nil)
+
+			c.JSON(200, gameAnswer)
+
+			return
+		}
+
+		right := lib.GetRight(cloze)
+
+		gameAnswer.Question = cl.getQuestion(word, cloze)
+		gameAnswer.Id = util.GenerateUid()
+
+		gameAnswer.SetDebugQuery(debug, &query, nil, word, &bson.M{"sentence": cloze.Sentence})
+		gameAnswer.SetDebugResult(debug, right, lang, word.GetMeanings(lang))
+
+		err = game.SaveAnswer(gameAnswer.GetId(), source, []string{word.GetId().Hex()}, right, mgoCollection)
+		if err != nil {
+			gameAnswer.Error = fmt.Sprint(err)
+		}
+
+		c.JSON(200, gameAnswer)
+	}
+}
+
+// loadCorpus reads the corpus file set in the environment, the game works with the words' examples only without it
+func (cl Cloze) loadCorpus() []string {
+	path := os.Getenv(lib.EnvCorpus)
+	if path == "" {
+		return []string{}
+	}
+
+	corpus, err := lib.LoadCorpus(path)
+	if err != nil {
+		log.Printf("Loading corpus failed: %v\n", err)
+	}
+
+	return corpus
+}
+
+func (cl Cloze) getQuestion(word entity.Word, cloze entity.Cloze) string {
+	if cloze.Translation == "" {
+		return fmt.Sprintf("Fill in the gap with a form of '%s': %s", word.GetGerman(), cloze.Sentence)
+	}
+
+	return fmt.Sprintf("Fill in the gap with a form of '%s': %s (%s)", word.GetGerman(), cloze.Sentence, cloze.Translation)
+}
+
+func (cl Cloze) MakeCheckAnswerHandle(finderUrl, scorerUrl string, mgoCollection *mgo.Collection, debug bool) func(c *gin.Context) {
+	return game.MakeCheckAnswerHandle(name, finderUrl, scorerUrl, mgoCollection, cl.gradeAnswer)
+}
+
+// gradeAnswer grades the word typed in the gap, other forms of the word asked are explained
+func (cl Cloze) gradeAnswer(tracker game.Tracker, answer string, words []entity.Word) (game.Answer, error) {
+	var (
+		score       int
+		explanation string
+	)
+
+	score = grade.GradeStrict(answer, tracker.Right)
+
+	if score < grade.ScoreCapitalization && len(words) > 0 && words[0] != nil && len(tracker.Right) > 0 {
+		if grade.GradeStrict(answer, entity.GetForms(words[0])) >= grade.ScoreCapitalization {
+			score = scoreWrongForm
+			explanation = fmt.Sprintf("Right word, wrong form: '%s' is a form of '%s', but the sentence needs '%s'.", strings.TrimSpace(answer), words[0].GetGerman(), tracker.Right[0])
+		}
+	}
+
+	return game.Answer{Answer: answer, Score: score, Right: tracker.Right, Explanation: explanation}, nil
+}
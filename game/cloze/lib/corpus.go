@@ -0,0 +1,81 @@
+package lib
+
+import (
+	"io/ioutil"
+	"math/rand"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/peteraba/d5/lib/german/entity"
+)
+
+// EnvCorpus is the environment variable holding the path of the plain text corpus file
+const EnvCorpus = "D5_CLOZE_CORPUS"
+
+// sentenceRegexp matches a sentence ending with a punctuation mark or the end of the line
+var sentenceRegexp = regexp.MustCompile(`[^.!?]*[.!?]+|[^.!?]+$`)
+
+// LoadCorpus reads a plain text file and splits it into sentences
+func LoadCorpus(path string) ([]string, error) {
+	sentences := []string{}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return sentences, err
+	}
+
+	for _, line := range strings.Split(string(content), "\n") {
+		for _, sentence := range sentenceRegexp.FindAllString(line, -1) {
+			sentence = strings.TrimSpace(sentence)
+			if strings.Contains(sentence, " ") {
+				sentences = append(sentences, sentence)
+			}
+		}
+	}
+
+	return sentences, nil
+}
+
+// FindClozes returns at most limit clozes from sentences of the corpus containing a form of the word, starting at a random sentence
+func FindClozes(corpus []string, word entity.Word, limit int) []entity.Cloze {
+	var (
+		clozes = []entity.Cloze{}
+		forms  = entity.GetForms(word)
+		start  int
+	)
+
+	if len(corpus) == 0 {
+		return clozes
+	}
+
+	start = rand.Intn(len(corpus))
+
+	for i := 0; i < len(corpus) && len(clozes) < limit; i++ {
+		sentence := corpus[(start+i)%len(corpus)]
+
+		if cloze, ok := entity.NewCloze(entity.Example{German: sentence}, forms); ok {
+			clozes = append(clozes, cloze)
+		}
+	}
+
+	return clozes
+}
+
+// GetRight returns the form found in the sentence, the capitalization of a gap starting the sentence is not asked
+func GetRight(cloze entity.Cloze) []string {
+	right := []string{cloze.Answer}
+
+	if !strings.HasPrefix(cloze.Sentence, entity.ClozeGap) {
+		return right
+	}
+
+	first, size := utf8.DecodeRuneInString(cloze.Answer)
+	lower := string(unicode.ToLower(first)) + cloze.Answer[size:]
+	if lower != cloze.Answer {
+		right = append(right, lower)
+	}
+
+	return right
+}
@@ -0,0 +1,111 @@
+package lib
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/peteraba/d5/lib/german/entity"
+)
+
+func TestLoadCorpus(t *testing.T) {
+	cases := []struct {
+		content  string
+		expected []string
+	}{
+		{"Ich gehe nach Hause. Er geht!\n", []string{"Ich gehe nach Hause.", "Er geht!"}},
+		{"Warum? Weil es regnet\n\nKurz.", []string{"Weil es regnet"}},
+		{"Was ist das?! Ein Tisch...  Der Tisch ist neu.", []string{"Was ist das?!", "Ein Tisch...", "Der Tisch ist neu."}},
+		{"", []string{}},
+	}
+
+	for num, testCase := range cases {
+		file, err := ioutil.TempFile("", "corpus")
+		if err != nil {
+			t.Fatalf("Case #%d: Creating corpus file failed: %v", num+1, err)
+		}
+
+		file.WriteString(testCase.content)
+		file.Close()
+
+		sentences, err := LoadCorpus(file.Name())
+		os.Remove(file.Name())
+
+		if err != nil {
+			t.Fatalf("Case #%d: Unexpected error: %v", num+1, err)
+		}
+
+		if !reflect.DeepEqual(sentences, testCase.expected) {
+			t.Fatalf("Case #%d: Expected: %q, got: %q", num+1, testCase.expected, sentences)
+		}
+	}
+
+	_, err := LoadCorpus("/nonexistent/corpus.txt")
+	if err == nil {
+		t.Fatalf("Expected error for a missing corpus file")
+	}
+
+	t.Log(len(cases)+1, "test cases")
+}
+
+func TestFindClozes(t *testing.T) {
+	var (
+		noun   = entity.NewNoun("r", "Tisch,⍨e", map[string]string{entity.LangEnglish: "table"}, "", "", "", "")
+		corpus = []string{"Die Tische sind neu.", "Ein Tischler kommt.", "Der Tisch ist alt.", "Wir essen."}
+	)
+
+	cases := []struct {
+		corpus   []string
+		limit    int
+		expected []string
+	}{
+		{corpus, 5, []string{"Der ___ ist alt.", "Die ___ sind neu."}},
+		{corpus[1:], 5, []string{"Der ___ ist alt."}},
+		{corpus[3:], 5, []string{}},
+		{[]string{}, 5, []string{}},
+	}
+
+	for num, testCase := range cases {
+		sentences := []string{}
+		for _, cloze := range FindClozes(testCase.corpus, noun, testCase.limit) {
+			sentences = append(sentences, cloze.Sentence)
+		}
+
+		sort.Strings(sentences)
+
+		if !reflect.DeepEqual(sentences, testCase.expected) {
+			t.Fatalf("Case #%d: Expected: %q, got: %q", num+1, testCase.expected, sentences)
+		}
+	}
+
+	if clozes := FindClozes(corpus, noun, 1); len(clozes) != 1 {
+		t.Fatalf("Expected 1 cloze with limit 1, got: %v", clozes)
+	}
+
+	t.Log(len(cases)+1, "test cases")
+}
+
+func TestGetRight(t *testing.T) {
+	cases := []struct {
+		cloze    entity.Cloze
+		expected []string
+	}{
+		{entity.Cloze{Sentence: "Die ___ sind neu.", Answer: "Tische"}, []string{"Tische"}},
+		{entity.Cloze{Sentence: "___ ist neu.", Answer: "Tisch"}, []string{"Tisch", "tisch"}},
+		{entity.Cloze{Sentence: "___ gehe nach Hause.", Answer: "Gehe"}, []string{"Gehe", "gehe"}},
+		{entity.Cloze{Sentence: "___ ist schön.", Answer: "Äpfel"}, []string{"Äpfel", "äpfel"}},
+		{entity.Cloze{Sentence: "___ kommt.", Answer: "er"}, []string{"er"}},
+	}
+
+	for num, testCase := range cases {
+		actual := GetRight(testCase.cloze)
+
+		if !reflect.DeepEqual(actual, testCase.expected) {
+			t.Fatalf("Case #%d: Expected: %q, got: %q", num+1, testCase.expected, actual)
+		}
+	}
+
+	t.Log(len(cases), "test cases")
+}
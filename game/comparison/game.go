@@ -0,0 +1,258 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	game "github.com/peteraba/d5/game/lib"
+	"github.com/peteraba/d5/lib/german/entity"
+	"github.com/peteraba/d5/lib/german/grade"
+	"github.com/peteraba/d5/lib/play"
+	"github.com/peteraba/d5/lib/util"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+const name = "Comparison"
+const version = "0.1"
+const defaultPort = "10500"
+
+const comparativeSuffix = "er"
+
+// superlativeSuffixes are the endings of superlatives shown in sentence frames, the longest one first
+var superlativeSuffixes = []string{"sten", "ten"}
+
+// noComparisonMark is stored instead of the forms of adjectives without comparison
+const noComparisonMark = "-"
+
+// noComparisonHint is shown with every question, so that it does not give away which adjectives have no comparison
+const noComparisonHint = "(type 'no comparison' if it has none)"
+
+// noComparison lists the accepted answers for adjectives without comparison
+var noComparison = []string{"no comparison", noComparisonMark}
+
+type Comparison struct{}
+
+/**
+ * MAIN
+ */
+
+func main() {
+	gameServer := Comparison{}
+	game.Main(name, version, defaultPort, gameServer)
+}
+
+// GetMeta describes the game for admin
+func (cmp Comparison) GetMeta() play.Meta {
+	return play.Meta{
+		Name:          name,
+		Description:   "Adjective comparison game: the comparative or the superlative of an adjective",
+		Categories:    []string{entity.CategoryAdjective},
+		RequiresWords: true,
+		Version:       version,
+	}
+}
+
+/**
+ * DOMAIN
+ */
+
+func (cmp Comparison) MakeGameHandle(finderUrl string, mgoCollection *mgo.Collection, debug bool) func(c *gin.Context) {
+	return func(c *gin.Context) {
+		var (
+			adjective  *entity.Adjective
+			query      = bson.M{}
+			gameAnswer = game.GameAnswer{}
+		)
+
+		lang := game.GetLanguage(c)
+
+		query["word.category"] = entity.CategoryAdjective
+		query["word.user"] = c.Param("user")
+		query["comparative.0"] = bson.M{"$exists": true}
+
+		dictionary, returnCode, err := game.FetchRemediatedDictionary(c, finderUrl, mgoCollection, name, query, 1)
+		if err != nil {
+			c.JSON(returnCode, fmt.Sprint(err))
+
+			return
+		}
+
+		if len(dictionary.Adjectives) > 0 {
+			adjective = &dictionary.Adjectives[0]
+		}
+
+		if adjective == nil {
+			gameAnswer.Error = "No adjectives found"
+			gameAnswer.SetDebugQuery(debug, &query, &dictionary, nil, nil)
+
+			c.JSON(200, gameAnswer)
+
+			return
+		}
+
+		isSuperlative := rand.Int31n(2) == 1
+		if !cmp.hasDegree(adjective, isSuperlative) {
+			isSuperlative = !isSuperlative
+		}
+
+		if !cmp.hasDegree(adjective, isSuperlative) {
+			gameAnswer.Error = fmt.Sprintf("No comparison stored for '%s'", adjective.GetGerman())
+
+			c.JSON(200, gameAnswer)
+
+			return
+		}
+
+		question, right, isFramed := cmp.getQuestion(adjective, isSuperlative, rand.Int31n(2) == 1)
+
+		gameAnswer.Question = question
+		gameAnswer.Id = util.GenerateUid()
+
+		gameAnswer.SetDebugQuery(debug, &query, nil, adjective, &bson.M{"superlative": isSuperlative, "framed": isFramed})
+		gameAnswer.SetDebugResult(debug, right, lang, adjective.GetMeanings(lang))
+
+		err = game.SaveAnswer(gameAnswer.GetId(), cmp.getQuestionType(isSuperlative, isFramed), []string{adjective.GetId().Hex()}, right, mgoCollection)
+		if err != nil {
+			gameAnswer.Error = fmt.Sprint(err)
+		}
+
+		c.JSON(200, gameAnswer)
+	}
+}
+
+// getRaw returns the comparative or superlative forms as stored, "-" marks adjectives without comparison
+func (cmp Comparison) getRaw(adjective *entity.Adjective, isSuperlative bool) []string {
+	if isSuperlative {
+		return adjective.Superlative
+	}
+
+	return adjective.Comparative
+}
+
+// hasDegree checks if the comparative or superlative is stored, either its forms or "-"
+func (cmp Comparison) hasDegree(adjective *entity.Adjective, isSuperlative bool) bool {
+	for _, raw := range cmp.getRaw(adjective, isSuperlative) {
+		if strings.TrimSpace(raw) != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isComparable checks if the forms stored are not only "-"
+func (cmp Comparison) isComparable(adjective *entity.Adjective, isSuperlative bool) bool {
+	for _, raw := range cmp.getRaw(adjective, isSuperlative) {
+		if raw = strings.TrimSpace(raw); raw != "" && raw != noComparisonMark {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getQuestion asks for the comparative or superlative, either bare or in a sentence frame showing the ending, forms
+// without the usual ending are always asked bare
+func (cmp Comparison) getQuestion(adjective *entity.Adjective, isSuperlative, isFramed bool) (string, []string, bool) {
+	var (
+		degree = "comparative"
+		forms  = getForms(adjective.GetComparative())
+		right  = []string{}
+	)
+
+	if isSuperlative {
+		degree = "superlative"
+		forms = getForms(adjective.GetSuperlative())
+	}
+
+	if !cmp.isComparable(adjective, isSuperlative) || len(forms) == 0 {
+		return fmt.Sprintf("What's the %s of '%s'? %s", degree, adjective.GetGerman(), noComparisonHint), noComparison, false
+	}
+
+	suffix := getSuffix(forms[0], isSuperlative)
+	if suffix == "" {
+		isFramed = false
+	}
+
+	for _, form := range forms {
+		right = append(right, form)
+
+		if isSuperlative {
+			right = append(right, "am "+form)
+		}
+
+		if isFramed && strings.HasSuffix(form, suffix) {
+			right = append(right, strings.TrimSuffix(form, suffix))
+		}
+	}
+
+	if !isFramed {
+		return fmt.Sprintf("What's the %s of '%s'? %s", degree, adjective.GetGerman(), noComparisonHint), right, false
+	}
+
+	frame := "Heute ist es ___" + suffix + " als gestern."
+	if isSuperlative {
+		frame = "Heute ist es am ___" + suffix + "."
+	}
+
+	return fmt.Sprintf("Fill in the %s of '%s': %s %s", degree, adjective.GetGerman(), frame, noComparisonHint), right, true
+}
+
+// getSuffix returns the ending shown in the sentence frame, superlatives like "größten" only end in "ten"
+func getSuffix(form string, isSuperlative bool) string {
+	suffixes := []string{comparativeSuffix}
+	if isSuperlative {
+		suffixes = superlativeSuffixes
+	}
+
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(form, suffix) && len(form) > len(suffix) {
+			return suffix
+		}
+	}
+
+	return ""
+}
+
+// getQuestionType describes the question asked, e.g. "superlative framed"
+func (cmp Comparison) getQuestionType(isSuperlative, isFramed bool) string {
+	var (
+		degree = "comparative"
+		frame  = "bare"
+	)
+
+	if isSuperlative {
+		degree = "superlative"
+	}
+
+	if isFramed {
+		frame = "framed"
+	}
+
+	return degree + " " + frame
+}
+
+// getForms drops the empty forms of adjectives without comparison
+func getForms(forms []string) []string {
+	result := []string{}
+
+	for _, form := range forms {
+		if form != "" {
+			result = append(result, form)
+		}
+	}
+
+	return result
+}
+
+func (cmp Comparison) MakeCheckAnswerHandle(finderUrl, scorerUrl string, mgoCollection *mgo.Collection, debug bool) func(c *gin.Context) {
+	return game.MakeCheckAnswerHandle(name, finderUrl, scorerUrl, mgoCollection, cmp.gradeAnswer)
+}
+
+// gradeAnswer grades the comparison form typed
+func (cmp Comparison) gradeAnswer(tracker game.Tracker, answer string, words []entity.Word) (game.Answer, error) {
+	return game.Answer{Answer: answer, Score: grade.GradeStrict(answer, tracker.Right), Right: tracker.Right}, nil
+}
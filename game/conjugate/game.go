@@ -8,6 +8,8 @@ import (
 	"github.com/peteraba/d5/game/conjugate/lib"
 	game "github.com/peteraba/d5/game/lib"
 	"github.com/peteraba/d5/lib/german/entity"
+	"github.com/peteraba/d5/lib/german/grade"
+	"github.com/peteraba/d5/lib/play"
 	"github.com/peteraba/d5/lib/util"
 	mgo "gopkg.in/mgo.v2"
 	"gopkg.in/mgo.v2/bson"
@@ -26,15 +28,27 @@ func main() {
 	game.Main(name, version, defaultPort, gameServer)
 }
 
+// GetMeta describes the game for admin
+func (conjugate Conjugate) GetMeta() play.Meta {
+	return play.Meta{
+		Name:          name,
+		Description:   "Verb conjugation game: a verb conjugated for a personal pronoun in a tense",
+		Categories:    []string{entity.CategoryVerb},
+		RequiresWords: true,
+		Version:       version,
+	}
+}
+
 type Conjugate struct{}
 
 func (conjugate Conjugate) MakeGameHandle(finderUrl string, mgoCollection *mgo.Collection, debug bool) func(context *gin.Context) {
 	return func(context *gin.Context) {
 		var verb *entity.Verb
 
+		lang := game.GetLanguage(context)
 		query, pp, tense := lib.GetRandomPieces(context.Param("user"))
 
-		dictionary, returnCode, err := game.FetchDictionary(finderUrl, query, 1)
+		dictionary, returnCode, err := game.FetchRemediatedDictionary(context, finderUrl, mgoCollection, name, query, 1)
 		if err != nil {
 			context.JSON(returnCode, fmt.Sprint(err))
 
@@ -45,15 +59,15 @@ func (conjugate Conjugate) MakeGameHandle(finderUrl string, mgoCollection *mgo.C
 			verb = &dictionary.Verbs[0]
 		}
 
-		gameAnswer, isRight := conjugate.getGameAnswer(verb, pp, tense)
+		gameAnswer, isRight := conjugate.getGameAnswer(verb, pp, tense, lang)
 
 		if verb == nil {
 			gameAnswer.SetDebugQuery(debug, &query, &dictionary, verb, &bson.M{"pp": pp, "tense": tense})
 		} else {
 			gameAnswer.SetDebugQuery(debug, &query, nil, verb, &bson.M{"pp": pp, "tense": tense})
-			gameAnswer.SetDebugResult(debug, isRight, verb.GetEnglish(), verb.GetThird())
+			gameAnswer.SetDebugResult(debug, isRight, lang, verb.GetMeanings(lang))
 
-			err := game.SaveAnswer(gameAnswer.GetId(), []string{verb.GetId().Hex()}, isRight, mgoCollection)
+			err := game.SaveAnswer(gameAnswer.GetId(), fmt.Sprintf("%s %s", tense, pp), []string{verb.GetId().Hex()}, isRight, mgoCollection)
 			if err != nil {
 				gameAnswer.Error = fmt.Sprint(err)
 			}
@@ -63,7 +77,7 @@ func (conjugate Conjugate) MakeGameHandle(finderUrl string, mgoCollection *mgo.C
 	}
 }
 
-func (conjugate Conjugate) getGameAnswer(verb *entity.Verb, pp entity.PersonalPronoun, tense entity.Tense) (game.GameAnswer, []string) {
+func (conjugate Conjugate) getGameAnswer(verb *entity.Verb, pp entity.PersonalPronoun, tense entity.Tense, lang string) (game.GameAnswer, []string) {
 	var right []string
 
 	game := game.GameAnswer{}
@@ -71,7 +85,7 @@ func (conjugate Conjugate) getGameAnswer(verb *entity.Verb, pp entity.PersonalPr
 	if verb == nil {
 		game.Error = "No verbs found"
 	} else {
-		game.Question = conjugate.getQuestion(*verb, pp, tense)
+		game.Question = conjugate.getQuestion(*verb, pp, tense, lang)
 		game.Id = util.GenerateUid()
 
 		right = verb.GetVerb(pp, tense)
@@ -83,7 +97,7 @@ func (conjugate Conjugate) getGameAnswer(verb *entity.Verb, pp entity.PersonalPr
 	return game, right
 }
 
-func (conjugate Conjugate) getQuestion(verb entity.Verb, pp entity.PersonalPronoun, tense entity.Tense) string {
+func (conjugate Conjugate) getQuestion(verb entity.Verb, pp entity.PersonalPronoun, tense entity.Tense, lang string) string {
 	var (
 		order      string
 		count      string
@@ -121,45 +135,21 @@ func (conjugate Conjugate) getQuestion(verb entity.Verb, pp entity.PersonalProno
 
 	tenseLower = strings.ToLower(fmt.Sprint(tense))
 
-	meanings = verb.GetEnglish()
+	meanings = verb.GetMeanings(lang)
 	if len(meanings) == 0 {
 		return fmt.Sprintf("What's the %s person, %s of '%s' in %s tense?", order, count, verb.GetGerman(), tenseLower)
 	}
 
 	meaning = meanings[0]
 
-	return fmt.Sprintf("What's the %s person, %s of '%s' in %s tense?", order, count, meaning.Main, tenseLower)
+	return fmt.Sprintf("What's the %s person, %s of '%s' in %s tense?", order, count, meaning.GetMain(), tenseLower)
 }
 
-func (conjugate Conjugate) MakeCheckAnswerHandle(finderUrl, scorerUrl string, mgoCollection *mgo.Collection, debug bool) func(context *gin.Context) {
-	return func(context *gin.Context) {
-		var (
-			err        error
-			returnCode int
-			tracker    game.Tracker
-			answerId   string
-			answer     string
-			score      int
-		)
-
-		answerId = context.PostForm("id")
-		answer = context.PostForm("answer")
-
-		tracker, err = game.FindAnswer(answerId, mgoCollection)
-		if err != nil {
-			context.JSON(returnCode, fmt.Sprint(err))
-
-			return
-		}
-
-		if util.StringIn(answer, tracker.Right) {
-			score = 10
-		} else {
-			score = 0
-		}
-
-		game.ScoreWords(scorerUrl, score, tracker.WordIds)
+func (conjugate Conjugate) MakeCheckAnswerHandle(finderUrl, scorerUrl string, mgoCollection *mgo.Collection, debug bool) func(c *gin.Context) {
+	return game.MakeCheckAnswerHandle(name, finderUrl, scorerUrl, mgoCollection, conjugate.gradeAnswer)
+}
 
-		context.JSON(200, score)
-	}
+// gradeAnswer grades the conjugated form typed, differences in the endings are wrong
+func (conjugate Conjugate) gradeAnswer(tracker game.Tracker, answer string, words []entity.Word) (game.Answer, error) {
+	return game.Answer{Answer: answer, Score: grade.GradeInflected(answer, tracker.Right), Right: tracker.Right}, nil
 }
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/peteraba/d5/game/declineadjective/lib"
+	game "github.com/peteraba/d5/game/lib"
+	"github.com/peteraba/d5/lib/german"
+	"github.com/peteraba/d5/lib/german/entity"
+	"github.com/peteraba/d5/lib/german/grade"
+	"github.com/peteraba/d5/lib/play"
+	"github.com/peteraba/d5/lib/util"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+const name = "DeclineAdjective"
+const version = "0.1"
+const defaultPort = "10460"
+
+type DeclineAdjective struct{}
+
+/**
+ * MAIN
+ */
+
+func main() {
+	gameServer := DeclineAdjective{}
+	game.Main(name, version, defaultPort, gameServer)
+}
+
+// GetMeta describes the game for admin
+func (d DeclineAdjective) GetMeta() play.Meta {
+	return play.Meta{
+		Name:          name,
+		Description:   "Adjective declension game: an adjective and a noun in a case, number, degree and with a determiner",
+		Categories:    []string{entity.CategoryAdjective, entity.CategoryNoun},
+		RequiresWords: true,
+		Version:       version,
+	}
+}
+
+/**
+ * DOMAIN
+ */
+
+func (d DeclineAdjective) MakeGameHandle(finderUrl string, mgoCollection *mgo.Collection, debug bool) func(c *gin.Context) {
+	return func(c *gin.Context) {
+		var (
+			adjective  *entity.Adjective
+			noun       *entity.Noun
+			dictionary = german.NewDictionary()
+			gameAnswer = game.GameAnswer{}
+			query      = bson.M{}
+			nounQuery  = bson.M{}
+		)
+
+		lang := game.GetLanguage(c)
+
+		query["word.user"] = c.Param("user")
+		query["word.category"] = entity.CategoryAdjective
+		nounQuery["word.user"] = c.Param("user")
+		nounQuery["word.category"] = entity.CategoryNoun
+
+		// mistakes are made in declining adjectives, nouns are only picked to go with them
+		found, returnCode, err := game.FetchRemediatedDictionary(c, finderUrl, mgoCollection, name, query, 1)
+		if err != nil {
+			c.JSON(returnCode, fmt.Sprint(err))
+
+			return
+		}
+
+		dictionary.Adjectives = found.Adjectives
+
+		found, returnCode, err = game.FetchDictionary(finderUrl, nounQuery, 1)
+		if err != nil {
+			c.JSON(returnCode, fmt.Sprint(err))
+
+			return
+		}
+
+		dictionary.Nouns = found.Nouns
+
+		if len(dictionary.Adjectives) > 0 {
+			adjective = &dictionary.Adjectives[0]
+		}
+
+		if len(dictionary.Nouns) > 0 && len(dictionary.Nouns[0].Articles) > 0 {
+			noun = &dictionary.Nouns[0]
+		}
+
+		if adjective == nil || noun == nil {
+			gameAnswer.Error = "No adjectives or nouns found"
+			gameAnswer.SetDebugQuery(debug, &query, &dictionary, nil, nil)
+
+			c.JSON(200, gameAnswer)
+
+			return
+		}
+
+		context := lib.GetRandomContext(adjective, noun)
+		right := lib.GetRight(adjective, noun, context)
+
+		gameAnswer.Question = lib.GetQuestion(adjective, noun, context)
+		gameAnswer.Id = util.GenerateUid()
+
+		gameAnswer.SetDebugQuery(debug, &query, &dictionary, adjective, &bson.M{"context": context})
+		gameAnswer.SetDebugResult(debug, right, lang, adjective.GetMeanings(lang))
+
+		if len(right) == 0 {
+			gameAnswer.Error = "No right answer found"
+
+			c.JSON(200, gameAnswer)
+
+			return
+		}
+
+		err = game.SaveAnswer(gameAnswer.GetId(), context.GetType(), []string{adjective.GetId().Hex()}, right, mgoCollection)
+		if err != nil {
+			gameAnswer.Error = fmt.Sprint(err)
+		}
+
+		c.JSON(200, gameAnswer)
+	}
+}
+
+func (d DeclineAdjective) MakeCheckAnswerHandle(finderUrl, scorerUrl string, mgoCollection *mgo.Collection, debug bool) func(c *gin.Context) {
+	return game.MakeCheckAnswerHandle(name, finderUrl, scorerUrl, mgoCollection, d.gradeAnswer)
+}
+
+// gradeAnswer grades the declined adjective typed
+func (d DeclineAdjective) gradeAnswer(tracker game.Tracker, answer string, words []entity.Word) (game.Answer, error) {
+	return game.Answer{Answer: answer, Score: grade.GradeStrict(answer, tracker.Right), Right: tracker.Right}, nil
+}
@@ -0,0 +1,121 @@
+package lib
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/peteraba/d5/lib/german/entity"
+	"github.com/peteraba/d5/lib/util"
+)
+
+var degreeNames = map[entity.Degree]string{
+	entity.Positive:    "positive",
+	entity.Comparative: "comparative",
+	entity.Superlative: "superlative",
+}
+
+var determinerNames = map[string]string{
+	DeterminerNone:       "without article",
+	DeterminerDefinite:   "with the definite article",
+	DeterminerIndefinite: "with the indefinite article",
+	DeterminerNegative:   "with 'kein'",
+}
+
+// GetDeterminer declines the determiner of the context for a noun article
+func GetDeterminer(context Context, article entity.Article) string {
+	switch context.Determiner {
+	case DeterminerNone:
+		return ""
+	case DeterminerDefinite:
+		return entity.DefiniteArticle(context.Determiner, article, context.IsPlural, context.Case)
+	}
+
+	return entity.IndefiniteArticle(context.Determiner, article, context.IsPlural, context.Case)
+}
+
+// GetRight returns the declined adjective or the whole phrase for each article of the noun
+func GetRight(adjective *entity.Adjective, noun *entity.Noun, context Context) []string {
+	var (
+		right     = []string{}
+		nounForms = noun.Decline(context.IsPlural, context.Case)
+	)
+
+	for _, article := range noun.Articles {
+		determiner := GetDeterminer(context, article)
+
+		for _, form := range adjective.Decline(context.Degree, context.Declension, article, context.IsPlural, context.Case) {
+			if form == "" {
+				continue
+			}
+
+			if !context.IsPhrase {
+				right = appendUnique(right, form)
+
+				continue
+			}
+
+			for _, nounForm := range nounForms {
+				right = appendUnique(right, join(determiner, form, nounForm))
+			}
+		}
+	}
+
+	return right
+}
+
+func GetQuestion(adjective *entity.Adjective, noun *entity.Noun, context Context) string {
+	var (
+		number    = "singular"
+		nounForm  = noun.GetGerman()
+		nounForms = noun.Decline(context.IsPlural, context.Case)
+	)
+
+	if context.IsPlural {
+		number = "plural"
+	}
+
+	if len(nounForms) > 0 {
+		nounForm = nounForms[0]
+	}
+
+	if !context.IsPhrase {
+		return fmt.Sprintf(
+			"Fill in the %s of '%s': %s (%s %s)",
+			degreeNames[context.Degree],
+			adjective.GetGerman(),
+			join(GetDeterminer(context, noun.Articles[0]), "___", nounForm),
+			entity.GetCaseName(context.Case),
+			number,
+		)
+	}
+
+	return fmt.Sprintf(
+		"What's the %s %s of '%s' + '%s' in %s %s?",
+		entity.GetCaseName(context.Case),
+		number,
+		adjective.GetGerman(),
+		noun.GetGerman(),
+		degreeNames[context.Degree],
+		determinerNames[context.Determiner],
+	)
+}
+
+func join(words ...string) string {
+	nonEmpty := []string{}
+
+	for _, word := range words {
+		if word != "" {
+			nonEmpty = append(nonEmpty, word)
+		}
+	}
+
+	return strings.Join(nonEmpty, " ")
+}
+
+func appendUnique(list []string, s string) []string {
+	if util.StringIn(s, list) {
+		return list
+	}
+
+	return append(list, s)
+}
@@ -0,0 +1,110 @@
+package lib
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/peteraba/d5/lib/german/entity"
+)
+
+const (
+	DeterminerNone       = ""
+	DeterminerDefinite   = "der"
+	DeterminerIndefinite = "ein"
+	DeterminerNegative   = "kein"
+)
+
+// Context is the phrase an adjective is declined in
+type Context struct {
+	Determiner string            `json:"determiner" bson:"determiner"`
+	Declension entity.Declension `json:"declension" bson:"declension"`
+	Degree     entity.Degree     `json:"degree" bson:"degree"`
+	IsPlural   bool              `json:"plural" bson:"plural"`
+	Case       entity.Case       `json:"case" bson:"case"`
+	IsPhrase   bool              `json:"phrase" bson:"phrase"`
+}
+
+var declensionNames = map[entity.Declension]string{
+	entity.Strong: "strong",
+	entity.Weak:   "weak",
+	entity.Mixed:  "mixed",
+}
+
+// GetType describes the context as question type, e.g. "comparative weak dative plural"
+func (c Context) GetType() string {
+	number := "singular"
+	if c.IsPlural {
+		number = "plural"
+	}
+
+	return fmt.Sprintf("%s %s %s %s", degreeNames[c.Degree], declensionNames[c.Declension], entity.GetCaseName(c.Case), number)
+}
+
+// GetRandomContext chooses a context the adjective and the noun can be used in
+func GetRandomContext(adjective *entity.Adjective, noun *entity.Noun) Context {
+	var (
+		determiners = []string{DeterminerNone, DeterminerDefinite, DeterminerIndefinite, DeterminerNegative}
+		context     = Context{}
+	)
+
+	context.Case = entity.Cases[rand.Intn(len(entity.Cases))]
+	context.Determiner = determiners[rand.Intn(len(determiners))]
+	context.Degree = getRandomDegree(adjective)
+	context.IsPhrase = rand.Int31n(2) == 1
+
+	if noun.IsPluralOnly {
+		context.IsPlural = true
+	} else if len(noun.GetPlurals()) > 0 {
+		context.IsPlural = rand.Int31n(2) == 1
+	}
+
+	// Indefinite article has no plural form
+	if context.IsPlural && context.Determiner == DeterminerIndefinite {
+		context.Determiner = DeterminerNone
+	}
+
+	// Superlatives are used with the definite article
+	if context.Degree == entity.Superlative {
+		context.Determiner = DeterminerDefinite
+	}
+
+	context.Declension = GetDeclension(context.Determiner)
+
+	return context
+}
+
+// GetDeclension returns the declension used after a determiner: strong without, weak after der, mixed after ein and kein
+func GetDeclension(determiner string) entity.Declension {
+	switch determiner {
+	case DeterminerNone:
+		return entity.Strong
+	case DeterminerDefinite:
+		return entity.Weak
+	}
+
+	return entity.Mixed
+}
+
+func getRandomDegree(adjective *entity.Adjective) entity.Degree {
+	degrees := []entity.Degree{entity.Positive}
+
+	if hasForms(adjective.GetComparative()) {
+		degrees = append(degrees, entity.Comparative)
+	}
+
+	if hasForms(adjective.GetSuperlative()) {
+		degrees = append(degrees, entity.Superlative)
+	}
+
+	return degrees[rand.Intn(len(degrees))]
+}
+
+func hasForms(forms []string) bool {
+	for _, form := range forms {
+		if form != "" {
+			return true
+		}
+	}
+
+	return false
+}
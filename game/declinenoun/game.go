@@ -0,0 +1,137 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/peteraba/d5/game/declinenoun/lib"
+	game "github.com/peteraba/d5/game/lib"
+	"github.com/peteraba/d5/lib/german/entity"
+	"github.com/peteraba/d5/lib/german/grade"
+	"github.com/peteraba/d5/lib/play"
+	"github.com/peteraba/d5/lib/util"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+const name = "DeclineNoun"
+const version = "0.1"
+const defaultPort = "10450"
+
+type DeclineNoun struct{}
+
+/**
+ * MAIN
+ */
+
+func main() {
+	gameServer := DeclineNoun{}
+	game.Main(name, version, defaultPort, gameServer)
+}
+
+// GetMeta describes the game for admin
+func (d DeclineNoun) GetMeta() play.Meta {
+	return play.Meta{
+		Name:          name,
+		Description:   "Noun declension game: a noun in a case and number with a determiner",
+		Categories:    []string{entity.CategoryNoun},
+		RequiresWords: true,
+		Version:       version,
+	}
+}
+
+/**
+ * DOMAIN
+ */
+
+func (d DeclineNoun) MakeGameHandle(finderUrl string, mgoCollection *mgo.Collection, debug bool) func(c *gin.Context) {
+	return func(c *gin.Context) {
+		var (
+			noun  *entity.Noun
+			query = bson.M{}
+		)
+
+		lang := game.GetLanguage(c)
+
+		query["word.category"] = entity.CategoryNoun
+		query["word.user"] = c.Param("user")
+
+		dictionary, returnCode, err := game.FetchRemediatedDictionary(c, finderUrl, mgoCollection, name, query, 1)
+		if err != nil {
+			c.JSON(returnCode, fmt.Sprint(err))
+
+			return
+		}
+
+		if len(dictionary.Nouns) > 0 {
+			noun = &dictionary.Nouns[0]
+		}
+
+		gameAnswer := game.GameAnswer{}
+		if noun == nil || len(noun.Articles) == 0 {
+			gameAnswer.Error = "No nouns found"
+			gameAnswer.SetDebugQuery(debug, &query, &dictionary, nil, nil)
+
+			c.JSON(200, gameAnswer)
+
+			return
+		}
+
+		isPlural, nounCase, determiner := lib.GetRandomPieces(noun)
+		right := noun.DeclineWithArticle(determiner, determiner == lib.DeterminerDefinite, isPlural, nounCase)
+
+		gameAnswer.Question = d.getQuestion(noun, isPlural, nounCase, determiner)
+		gameAnswer.Id = util.GenerateUid()
+
+		gameAnswer.SetDebugQuery(debug, &query, nil, noun, &bson.M{"plural": isPlural, "case": nounCase, "determiner": determiner})
+		gameAnswer.SetDebugResult(debug, right, lang, noun.GetMeanings(lang))
+
+		err = game.SaveAnswer(gameAnswer.GetId(), d.getQuestionType(isPlural, nounCase, determiner), []string{noun.GetId().Hex()}, right, mgoCollection)
+		if err != nil {
+			gameAnswer.Error = fmt.Sprint(err)
+		}
+
+		c.JSON(200, gameAnswer)
+	}
+}
+
+// getQuestionType describes the question asked, e.g. "dative plural kein"
+func (d DeclineNoun) getQuestionType(isPlural bool, nounCase entity.Case, determiner string) string {
+	number := "singular"
+	if isPlural {
+		number = "plural"
+	}
+
+	return fmt.Sprintf("%s %s %s", entity.GetCaseName(nounCase), number, determiner)
+}
+
+func (d DeclineNoun) getQuestion(noun *entity.Noun, isPlural bool, nounCase entity.Case, determiner string) string {
+	var (
+		number  = "singular"
+		article = "the definite article"
+	)
+
+	if isPlural {
+		number = "plural"
+	}
+
+	switch determiner {
+	case lib.DeterminerIndefinite:
+		article = "the indefinite article"
+		break
+	case lib.DeterminerNegative:
+		article = "'kein'"
+		break
+	}
+
+	return fmt.Sprintf("What's the %s %s of '%s' with %s?", entity.GetCaseName(nounCase), number, noun.GetGerman(), article)
+}
+
+func (d DeclineNoun) MakeCheckAnswerHandle(finderUrl, scorerUrl string, mgoCollection *mgo.Collection, debug bool) func(c *gin.Context) {
+	return game.MakeCheckAnswerHandle(name, finderUrl, scorerUrl, mgoCollection, d.gradeAnswer)
+}
+
+// gradeAnswer grades the declined noun typed
+func (d DeclineNoun) gradeAnswer(tracker game.Tracker, answer string, words []entity.Word) (game.Answer, error) {
+	return game.Answer{Answer: answer, Score: grade.GradeStrict(answer, tracker.Right), Right: tracker.Right}, nil
+}
@@ -0,0 +1,46 @@
+package lib
+
+import (
+	"math/rand"
+
+	"github.com/peteraba/d5/lib/german/entity"
+)
+
+const (
+	DeterminerDefinite   = "der"
+	DeterminerIndefinite = "ein"
+	DeterminerNegative   = "kein"
+)
+
+// GetRandomPieces chooses number, case and determiner for a noun, plural only nouns and nouns without plural are asked in their only number
+func GetRandomPieces(noun *entity.Noun) (bool, entity.Case, string) {
+	var (
+		isPlural   bool
+		nounCase   = entity.Cases[rand.Intn(len(entity.Cases))]
+		determiner string
+	)
+
+	if noun.IsPluralOnly {
+		isPlural = true
+	} else if len(noun.GetPlurals()) > 0 {
+		isPlural = rand.Int31n(2) == 1
+	}
+
+	switch rand.Int31n(3) {
+	case 0:
+		determiner = DeterminerDefinite
+		break
+	case 1:
+		determiner = DeterminerIndefinite
+		break
+	default:
+		determiner = DeterminerNegative
+	}
+
+	// Indefinite article has no plural form
+	if isPlural && determiner == DeterminerIndefinite {
+		determiner = DeterminerDefinite
+	}
+
+	return isPlural, nounCase, determiner
+}
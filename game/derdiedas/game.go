@@ -2,11 +2,14 @@ package main
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	game "github.com/peteraba/d5/game/lib"
-	"github.com/peteraba/d5/lib/german"
 	"github.com/peteraba/d5/lib/german/entity"
+	"github.com/peteraba/d5/lib/german/grade"
+	"github.com/peteraba/d5/lib/play"
+	"github.com/peteraba/d5/lib/util"
 	"gopkg.in/mgo.v2"
 	"gopkg.in/mgo.v2/bson"
 )
@@ -15,6 +18,9 @@ const name = "DerDieDas"
 const version = "0.1"
 const defaultPort = "10410"
 
+// questionType is sent to the scorer and recorded with mistakes, remediation groups article mistakes by it
+const questionType = "article"
+
 type DerDieDas struct{}
 
 /**
@@ -26,90 +32,113 @@ func main() {
 	game.Main(name, version, defaultPort, gameServer)
 }
 
+// GetMeta describes the game for admin
+func (d DerDieDas) GetMeta() play.Meta {
+	return play.Meta{
+		Name:          name,
+		Description:   "Article game: der, die or das of a noun",
+		Categories:    []string{entity.CategoryNoun},
+		RequiresWords: true,
+		Version:       version,
+	}
+}
+
 func (d DerDieDas) MakeGameHandle(finderUrl string, mgoCollection *mgo.Collection, debug bool) func(c *gin.Context) {
 	return func(c *gin.Context) {
 		var (
 			words      []entity.Word
-			word       entity.Word
+			noun       *entity.Noun
 			query      = bson.M{}
+			gameOption = game.GameOption{}
 			err        error
 			returnCode int
 		)
 
+		lang := game.GetLanguage(c)
+
 		query["word.category"] = "noun"
 		query["word.user"] = c.Param("user")
 
-		words, returnCode, err = game.FetchWords(finderUrl, query, 1)
+		words, returnCode, err = game.FetchRemediatedWords(c, finderUrl, mgoCollection, name, query, 1)
 		if err != nil {
 			c.JSON(returnCode, fmt.Sprint(err))
 
 			return
 		}
 
-		word = words[0]
+		noun, ok := words[0].(*entity.Noun)
+		if !ok || len(d.GetRight(*noun)) == 0 {
+			gameOption.Error = "No nouns found"
+			gameOption.SetDebugQuery(debug, &query, nil, words[0], nil)
 
-		game := game.GameOption{}
+			c.JSON(200, gameOption)
 
-		game.Question = fmt.Sprintf("What's the article of '%s'?", word.GetGerman())
-		game.Option1 = "der"
-		game.Option2 = "die"
-		game.Option3 = "das"
-		game.Id = word.GetId().Hex()
+			return
+		}
 
-		c.JSON(200, game)
-	}
-}
+		right := d.GetRight(*noun)
 
-func (d DerDieDas) MakeCheckAnswerHandle(finderUrl, scorerUrl string, mgoCollection *mgo.Collection, isDebug bool) func(c *gin.Context) {
-	return func(c *gin.Context) {
-		var (
-			query       = bson.M{}
-			dictionary  german.Dictionary
-			noun        entity.Noun
-			err         error
-			returnCode  int
-			answerScore int
-		)
+		gameOption.Question = fmt.Sprintf("What's the article of '%s'?", noun.GetGerman())
+		gameOption.Option1 = "der"
+		gameOption.Option2 = "die"
+		gameOption.Option3 = "das"
+		gameOption.Id = util.GenerateUid()
 
-		query["__id"] = c.PostForm("id")
+		gameOption.SetDebugQuery(debug, &query, nil, noun, nil)
+		gameOption.SetDebugResult(debug, right, lang, noun.GetMeanings(lang))
 
-		dictionary, returnCode, err = game.FetchDictionary(finderUrl, query, 1)
+		err = game.SaveAnswer(gameOption.GetId(), questionType, []string{noun.GetId().Hex()}, right, mgoCollection)
 		if err != nil {
-			c.JSON(returnCode, fmt.Sprint(err))
-
-			return
+			gameOption.Error = fmt.Sprint(err)
 		}
 
-		if len(dictionary.Nouns) == 0 {
-			c.JSON(returnCode, "No noun was found.")
-
-			return
-		}
-
-		noun = dictionary.Nouns[0]
+		c.JSON(200, gameOption)
+	}
+}
 
-		answerScore = d.CheckAnswer(noun, c.PostForm("answer"))
+func (d DerDieDas) MakeCheckAnswerHandle(finderUrl, scorerUrl string, mgoCollection *mgo.Collection, debug bool) func(c *gin.Context) {
+	return game.MakeCheckAnswerHandle(name, finderUrl, scorerUrl, mgoCollection, d.gradeAnswer)
+}
 
-		game.ScoreWords(scorerUrl, answerScore, []string{c.PostForm("id")})
+// gradeAnswer grades the article chosen or typed
+func (d DerDieDas) gradeAnswer(tracker game.Tracker, answer string, words []entity.Word) (game.Answer, error) {
+	answer = d.getAnswer(answer)
 
-		c.JSON(200, answerScore)
-	}
+	return game.Answer{Answer: answer, Score: d.CheckAnswer(answer, tracker.Right), Right: tracker.Right}, nil
 }
 
-func (d DerDieDas) CheckAnswer(word entity.Noun, result string) int {
+func (d DerDieDas) GetRight(word entity.Noun) []string {
+	right := []string{}
+
 	for _, article := range word.Articles {
-		if article == entity.Der && result == "1" {
-			return 10
+		switch article {
+		case entity.Der:
+			right = append(right, "der")
+		case entity.Die:
+			right = append(right, "die")
+		case entity.Das:
+			right = append(right, "das")
 		}
+	}
 
-		if article == entity.Die && result == "2" {
-			return 10
-		}
+	return right
+}
 
-		if article == entity.Das && result == "3" {
-			return 10
-		}
+// CheckAnswer grades the article chosen against the articles of the noun asked
+func (d DerDieDas) CheckAnswer(answer string, right []string) int {
+	return grade.Grade(answer, right)
+}
+
+// getAnswer returns the article chosen by the number of the option or the article typed
+func (d DerDieDas) getAnswer(result string) string {
+	switch strings.Trim(result, " ") {
+	case "1":
+		return "der"
+	case "2":
+		return "die"
+	case "3":
+		return "das"
 	}
 
-	return 0
+	return result
 }
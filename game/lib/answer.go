@@ -0,0 +1,56 @@
+package game
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/peteraba/d5/lib/german/entity"
+	"gopkg.in/mgo.v2"
+)
+
+// Answer is a graded answer, right holds the answers shown to the user and recorded with the score
+type Answer struct {
+	Answer      string
+	Score       int
+	Right       []string
+	Explanation string
+}
+
+// Grader grades the answer given to the question of the tracker, the words asked are passed for explanations
+type Grader func(tracker Tracker, answer string, words []entity.Word) (Answer, error)
+
+// MakeCheckAnswerHandle grades answers with the grader of a game, scores the words asked and records mistakes
+func MakeCheckAnswerHandle(gameName, finderUrl, scorerUrl string, mgoCollection *mgo.Collection, grader Grader) func(c *gin.Context) {
+	return func(c *gin.Context) {
+		tracker, returnCode, err := FindAnswer(c.PostForm("id"), mgoCollection)
+		if err != nil {
+			c.JSON(returnCode, fmt.Sprint(err))
+
+			return
+		}
+
+		words, _, err := FetchWordsById(finderUrl, tracker.WordIds)
+		if err != nil {
+			log.Printf("Fetching words for feedback failed: %v\n", err)
+		}
+
+		answer, err := grader(tracker, c.PostForm("answer"), words)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, fmt.Sprint(err))
+
+			return
+		}
+
+		event := NewScoreEvent(gameName, tracker, answer.Answer, answer.Right)
+		ScoreWords(scorerUrl, answer.Score, event, tracker.WordIds)
+
+		SaveMistake(mgoCollection, c.Param("user"), answer.Score, event, tracker.WordIds, words...)
+
+		result := NewGameResult(answer.Score, answer.Right, words...)
+		result.Explanation = answer.Explanation
+
+		c.JSON(http.StatusOK, result)
+	}
+}
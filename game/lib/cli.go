@@ -0,0 +1,311 @@
+package game
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/peteraba/d5/lib/german/grade"
+)
+
+const (
+	actionPlay   = "play"
+	actionGame   = "game"
+	actionAnswer = "answer"
+)
+
+var quitCommands = []string{"q", "quit", "exit"}
+
+// cliQuestion holds the fields of both GameAnswer and GameOption responses
+type cliQuestion struct {
+	Question string `json:"question"`
+	Option1  string `json:"option1"`
+	Option2  string `json:"option2"`
+	Option3  string `json:"option3"`
+	Option4  string `json:"option4"`
+	Id       string `json:"id"`
+	Error    string `json:"error"`
+}
+
+func (q cliQuestion) getOptions() []string {
+	options := []string{}
+
+	for _, option := range []string{q.Option1, q.Option2, q.Option3, q.Option4} {
+		if option != "" {
+			options = append(options, option)
+		}
+	}
+
+	return options
+}
+
+// scriptLine is printed for each question answered in script mode
+type scriptLine struct {
+	Id       string     `json:"id"`
+	Question string     `json:"question"`
+	Answer   string     `json:"answer"`
+	Result   GameResult `json:"result"`
+}
+
+// cliClient calls the handlers of a game server directly, without opening a port
+type cliClient struct {
+	router        *gin.Engine
+	user          string
+	lang          string
+	isRemediation bool
+}
+
+func newCliClient(router *gin.Engine, user, lang string, isRemediation bool) cliClient {
+	return cliClient{router, user, lang, isRemediation}
+}
+
+func (cc cliClient) call(method, path string, data url.Values) ([]byte, error) {
+	var (
+		req *http.Request
+		err error
+	)
+
+	path = fmt.Sprintf("%s/%s?lang=%s", path, url.PathEscape(cc.user), url.QueryEscape(cc.lang))
+	if cc.isRemediation {
+		path += "&remediation=1"
+	}
+
+	if data == nil {
+		req, err = http.NewRequest(method, path, nil)
+	} else {
+		req, err = http.NewRequest(method, path, strings.NewReader(data.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	recorder := httptest.NewRecorder()
+	cc.router.ServeHTTP(recorder, req)
+
+	if recorder.Code != http.StatusOK {
+		return recorder.Body.Bytes(), errors.New(fmt.Sprintf("Request failed with status %d: %s", recorder.Code, recorder.Body.String()))
+	}
+
+	return recorder.Body.Bytes(), nil
+}
+
+func (cc cliClient) getQuestion() (cliQuestion, []byte, error) {
+	question := cliQuestion{}
+
+	body, err := cc.call("GET", "/game", nil)
+	if err != nil {
+		return question, body, err
+	}
+
+	err = json.Unmarshal(body, &question)
+	if err != nil {
+		return question, body, errors.New(fmt.Sprintf("Invalid game response: %s", string(body)))
+	}
+
+	return question, body, nil
+}
+
+func (cc cliClient) postAnswer(id, answer string) (GameResult, []byte, error) {
+	var (
+		result = GameResult{}
+		data   = url.Values{}
+	)
+
+	data.Set("id", id)
+	data.Set("answer", answer)
+
+	body, err := cc.call("POST", "/answer", data)
+	if err != nil {
+		return result, body, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return result, body, errors.New(fmt.Sprintf("Invalid answer response: %s", string(body)))
+	}
+
+	return result, body, nil
+}
+
+// runCli runs the given action, questions are printed as JSON for "game", "answer" expects the answer id and the answer on standard input
+func runCli(cc cliClient, action string, isScript bool, in io.Reader, out io.Writer) error {
+	scanner := bufio.NewScanner(in)
+
+	switch action {
+	case actionGame:
+		_, body, err := cc.getQuestion()
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintln(out, string(body))
+
+		return nil
+	case actionAnswer:
+		lines := []string{}
+		for len(lines) < 2 && scanner.Scan() {
+			lines = append(lines, strings.TrimSpace(scanner.Text()))
+		}
+
+		if len(lines) < 2 {
+			return errors.New("The answer id and the answer are expected on the first two lines of standard input.")
+		}
+
+		_, body, err := cc.postAnswer(lines[0], lines[1])
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintln(out, string(body))
+
+		return nil
+	case actionPlay, "":
+		if isScript {
+			return playScript(cc, scanner, out)
+		}
+
+		return playInteractive(cc, scanner, out)
+	}
+
+	return errors.New(fmt.Sprintf("Unknown action: %s", action))
+}
+
+// playInteractive asks questions until the input ends or the user quits, feedback is given after each answer
+func playInteractive(cc cliClient, scanner *bufio.Scanner, out io.Writer) error {
+	var (
+		count int
+		total int
+	)
+
+	fmt.Fprintf(out, "Type your answer after each question, '%s' to stop.\n\n", quitCommands[1])
+
+	for {
+		question, _, err := cc.getQuestion()
+		if err != nil {
+			return err
+		}
+
+		if question.Error != "" {
+			fmt.Fprintln(out, question.Error)
+
+			break
+		}
+
+		fmt.Fprintln(out, question.Question)
+		for i, option := range question.getOptions() {
+			fmt.Fprintf(out, "  %d) %s\n", i+1, option)
+		}
+		fmt.Fprint(out, "> ")
+
+		if !scanner.Scan() {
+			fmt.Fprintln(out)
+
+			break
+		}
+
+		answer := strings.TrimSpace(scanner.Text())
+		if isQuit(answer) {
+			break
+		}
+
+		result, _, err := cc.postAnswer(question.Id, answer)
+		if err != nil {
+			return err
+		}
+
+		count++
+		total += result.Score
+
+		printResult(out, result)
+	}
+
+	if count > 0 {
+		fmt.Fprintf(out, "Answered: %d, average score: %.1f\n", count, float64(total)/float64(count))
+	}
+
+	return nil
+}
+
+// playScript answers a question with each line of the input and prints the results as JSON lines
+func playScript(cc cliClient, scanner *bufio.Scanner, out io.Writer) error {
+	encoder := json.NewEncoder(out)
+
+	for scanner.Scan() {
+		question, _, err := cc.getQuestion()
+		if err != nil {
+			return err
+		}
+
+		if question.Error != "" {
+			return errors.New(question.Error)
+		}
+
+		answer := strings.TrimSpace(scanner.Text())
+
+		result, _, err := cc.postAnswer(question.Id, answer)
+		if err != nil {
+			return err
+		}
+
+		err = encoder.Encode(scriptLine{question.Id, question.Question, answer, result})
+		if err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
+
+func printResult(out io.Writer, result GameResult) {
+	switch {
+	case result.Score >= grade.ScoreRight:
+		fmt.Fprintln(out, "Right!")
+		break
+	case result.Score > grade.ScoreWrong:
+		fmt.Fprintf(out, "Almost, score: %d\n", result.Score)
+		break
+	default:
+		fmt.Fprintln(out, "Wrong.")
+	}
+
+	if len(result.Right) > 0 {
+		fmt.Fprintf(out, "Right answer: %s\n", strings.Join(result.Right, ", "))
+	}
+
+	if result.Explanation != "" {
+		fmt.Fprintln(out, result.Explanation)
+	}
+
+	for _, example := range result.Examples {
+		if example.Translation == "" {
+			fmt.Fprintf(out, "  %s\n", example.German)
+		} else {
+			fmt.Fprintf(out, "  %s = %s\n", example.German, example.Translation)
+		}
+	}
+
+	for _, note := range result.Notes {
+		fmt.Fprintf(out, "  %s\n", note)
+	}
+
+	fmt.Fprintln(out)
+}
+
+func isQuit(answer string) bool {
+	for _, command := range quitCommands {
+		if strings.ToLower(answer) == command {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,193 @@
+package game
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/peteraba/d5/lib/german/grade"
+	"github.com/peteraba/d5/lib/play"
+	"gopkg.in/mgo.v2"
+)
+
+// stubGame asks how much one plus one is, questions are numbered by the calls made
+type stubGame struct {
+	count *int
+}
+
+func (sg stubGame) GetMeta() play.Meta {
+	return play.Meta{Name: "Stub", Version: "0.1"}
+}
+
+func (sg stubGame) MakeGameHandle(finderUrl string, mgoCollection *mgo.Collection, isDebug bool) func(*gin.Context) {
+	return func(c *gin.Context) {
+		*sg.count++
+
+		c.JSON(200, GameAnswer{Question: "1 + 1 = ?", Id: fmt.Sprintf("q%d", *sg.count)})
+	}
+}
+
+func (sg stubGame) MakeCheckAnswerHandle(finderUrl, scorerUrl string, mgoCollection *mgo.Collection, isDebug bool) func(*gin.Context) {
+	return func(c *gin.Context) {
+		score := grade.ScoreWrong
+		if c.PostForm("answer") == "2" {
+			score = grade.ScoreRight
+		}
+
+		result := NewGameResult(score, []string{"2"})
+		result.Explanation = "Answered " + c.PostForm("id") + " for " + c.Param("user")
+
+		c.JSON(200, result)
+	}
+}
+
+func newStubClient() cliClient {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	addRoutes(router, stubGame{new(int)}, nil, "", "", false)
+
+	return newCliClient(router, "john_doe", "en", false)
+}
+
+func TestRunCliScript(t *testing.T) {
+	out := &bytes.Buffer{}
+
+	err := runCli(newStubClient(), actionPlay, true, strings.NewReader("2\n 3 \n"), out)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	cases := []struct {
+		id     string
+		answer string
+		score  int
+	}{
+		{"q1", "2", grade.ScoreRight},
+		{"q2", "3", grade.ScoreWrong},
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != len(cases) {
+		t.Fatalf("Expected %d lines, got: %q", len(cases), out.String())
+	}
+
+	for num, testCase := range cases {
+		line := scriptLine{}
+
+		err = json.Unmarshal([]byte(lines[num]), &line)
+		if err != nil {
+			t.Fatalf("Case #%d: Invalid JSON line: %s", num+1, lines[num])
+		}
+
+		if line.Id != testCase.id || line.Answer != testCase.answer || line.Question != "1 + 1 = ?" {
+			t.Fatalf("Case #%d: Unexpected line: %s", num+1, lines[num])
+		}
+
+		if line.Result.Score != testCase.score || strings.Join(line.Result.Right, ",") != "2" {
+			t.Fatalf("Case #%d: Unexpected result: %s", num+1, lines[num])
+		}
+	}
+
+	t.Log(len(cases), "test cases")
+}
+
+func TestRunCliInteractive(t *testing.T) {
+	cases := []struct {
+		input     string
+		contains  []string
+		questions int
+	}{
+		{"2\n3\n", []string{"Right!", "Wrong.", "Right answer: 2", "Answered: 2, average score: 5.0"}, 3},
+		{"2\nquit\n3\n", []string{"Right!", "Answered: 1, average score: 10.0"}, 2},
+		{"Q\n", []string{"1 + 1 = ?"}, 1},
+	}
+
+	for num, testCase := range cases {
+		out := &bytes.Buffer{}
+
+		err := runCli(newStubClient(), actionPlay, false, strings.NewReader(testCase.input), out)
+		if err != nil {
+			t.Fatalf("Case #%d: Unexpected error: %v", num+1, err)
+		}
+
+		for _, expected := range testCase.contains {
+			if !strings.Contains(out.String(), expected) {
+				t.Fatalf("Case #%d: Expected '%s' in output, got: %s", num+1, expected, out.String())
+			}
+		}
+
+		if strings.Count(out.String(), "1 + 1 = ?") != testCase.questions {
+			t.Fatalf("Case #%d: Expected %d questions, got: %s", num+1, testCase.questions, out.String())
+		}
+
+		if testCase.questions == 1 && strings.Contains(out.String(), "Answered:") {
+			t.Fatalf("Case #%d: Unexpected summary after quitting: %s", num+1, out.String())
+		}
+	}
+
+	t.Log(len(cases), "test cases")
+}
+
+func TestRunCliAnswer(t *testing.T) {
+	cases := []struct {
+		input    string
+		isError  bool
+		score    int
+		expected string
+	}{
+		{"q7\n2\n", false, grade.ScoreRight, "Answered q7 for john_doe"},
+		{"q8\n 5 \n", false, grade.ScoreWrong, "Answered q8 for john_doe"},
+		{"q9\n", true, 0, ""},
+	}
+
+	for num, testCase := range cases {
+		out := &bytes.Buffer{}
+
+		err := runCli(newStubClient(), actionAnswer, false, strings.NewReader(testCase.input), out)
+		if (err != nil) != testCase.isError {
+			t.Fatalf("Case #%d: Unexpected error: %v", num+1, err)
+		}
+
+		if testCase.isError {
+			continue
+		}
+
+		result := GameResult{}
+
+		err = json.Unmarshal(out.Bytes(), &result)
+		if err != nil {
+			t.Fatalf("Case #%d: Invalid JSON: %s", num+1, out.String())
+		}
+
+		if result.Score != testCase.score || result.Explanation != testCase.expected {
+			t.Fatalf("Case #%d: Unexpected result: %s", num+1, out.String())
+		}
+	}
+
+	t.Log(len(cases), "test cases")
+}
+
+func TestRunCliGame(t *testing.T) {
+	out := &bytes.Buffer{}
+
+	err := runCli(newStubClient(), actionGame, false, strings.NewReader(""), out)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	question := cliQuestion{}
+
+	err = json.Unmarshal(out.Bytes(), &question)
+	if err != nil || question.Id != "q1" || question.Question != "1 + 1 = ?" {
+		t.Fatalf("Unexpected question: %s", out.String())
+	}
+
+	err = runCli(newStubClient(), "unknown", false, strings.NewReader(""), out)
+	if err == nil {
+		t.Fatalf("Expected error for unknown action")
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"log"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/peteraba/d5/lib/german"
 	"github.com/peteraba/d5/lib/german/entity"
@@ -66,6 +67,27 @@ func FetchWords(finderUrl string, query bson.M, limit int) ([]entity.Word, int,
 
 }
 
+// FetchWordsById fetches the words with the given ids one by one, words not found are skipped
+func FetchWordsById(finderUrl string, ids []string) ([]entity.Word, int, error) {
+	var (
+		words      = []entity.Word{}
+		found      []entity.Word
+		err        error
+		returnCode int
+	)
+
+	for _, id := range ids {
+		found, returnCode, err = FetchWords(finderUrl, bson.M{"__id": id}, 1)
+		if err != nil && returnCode != 204 {
+			return words, returnCode, err
+		}
+
+		words = append(words, found...)
+	}
+
+	return words, 200, nil
+}
+
 func retrieveWords(finderUrl string, query bson.M, limit int) ([]byte, int, error) {
 	var (
 		data  = url.Values{}
@@ -97,14 +119,42 @@ func retrieveWords(finderUrl string, query bson.M, limit int) ([]byte, int, erro
 	return bytes, 200, nil
 }
 
-func ScoreWords(scorerUrl string, score int, ids []string) {
+// ScoreEvent describes the answer a score was given for
+type ScoreEvent struct {
+	Game         string
+	QuestionType string
+	Answer       string
+	Right        []string
+	Latency      time.Duration
+}
+
+// NewScoreEvent creates the score event of an answer, the latency is the time passed since the question was saved
+func NewScoreEvent(gameName string, tracker Tracker, answer string, right []string) ScoreEvent {
+	var latency time.Duration
+
+	if !tracker.CreatedAt.IsZero() {
+		latency = time.Since(tracker.CreatedAt)
+	}
+
+	return ScoreEvent{gameName, tracker.QuestionType, answer, right, latency}
+}
+
+func ScoreWords(scorerUrl string, score int, event ScoreEvent, ids []string) {
 	var (
 		data = url.Values{}
 	)
 
+	data.Set("score", fmt.Sprintf("%d", score))
+	data.Set("game", event.Game)
+	data.Set("questionType", event.QuestionType)
+	data.Set("answer", event.Answer)
+	data.Set("latency", fmt.Sprintf("%d", int64(event.Latency/time.Millisecond)))
+	for _, right := range event.Right {
+		data.Add("right", right)
+	}
+
 	for i := 0; i < len(ids); i++ {
 		data.Set("wordId", ids[i])
-		data.Set("score", fmt.Sprintf("%d", score))
 
 		http.PostForm(scorerUrl, data)
 	}
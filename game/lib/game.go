@@ -10,7 +10,7 @@ type Game interface {
 	GetQuestion() string
 	GetId() string
 	SetDebugQuery(bool, *bson.M, *german.Dictionary, entity.Word, *bson.M)
-	SetDebugResult(bool, []string, []entity.Meaning, []entity.Meaning)
+	SetDebugResult(bool, []string, string, []entity.Meaning)
 }
 
 type GameOption struct {
@@ -57,6 +57,33 @@ func (g GameAnswer) GetId() string {
 	return g.Id
 }
 
+type GameResult struct {
+	Score       int              `json:"score" bson:"score"`
+	Right       []string         `json:"right,omitempty" bson:"right,omitempty"`
+	Examples    []entity.Example `json:"examples,omitempty" bson:"examples,omitempty"`
+	Notes       []string         `json:"notes,omitempty" bson:"notes,omitempty"`
+	Explanation string           `json:"explanation,omitempty" bson:"explanation,omitempty"`
+}
+
+// NewGameResult creates the feedback sent after an answer, examples and notes are collected from the words asked
+func NewGameResult(score int, right []string, words ...entity.Word) GameResult {
+	result := GameResult{Score: score, Right: right}
+
+	for _, word := range words {
+		if word == nil {
+			continue
+		}
+
+		result.Examples = append(result.Examples, word.GetExamples()...)
+
+		if word.GetNotes() != "" {
+			result.Notes = append(result.Notes, word.GetNotes())
+		}
+	}
+
+	return result
+}
+
 type GameDebug struct {
 	Error      string             `json:"error,omitempty" bson:"error,omitempty"`
 	Query      *bson.M            `json:"query,omitempty" bson:"query,omitempty"`
@@ -64,8 +91,8 @@ type GameDebug struct {
 	Word       entity.Word        `json:"word,omitempty" bson:"word,omitempty"`
 	Options    *bson.M            `json:"options,omitempty" bson:"options,omitempty"`
 	Right      []string           `json:"right,omitempty" bson:"right,omitempty"`
-	English    []entity.Meaning   `json:"english,omitempty" bson:",omitempty"`
-	Third      []entity.Meaning   `json:"third,omitempty" bson:"third,omitempty"`
+	Language   string             `json:"language,omitempty" bson:"language,omitempty"`
+	Meanings   []entity.Meaning   `json:"meanings,omitempty" bson:"meanings,omitempty"`
 }
 
 func (g *GameDebug) SetDebugQuery(debug bool, query *bson.M, dictionary *german.Dictionary, word entity.Word, options *bson.M) {
@@ -79,12 +106,12 @@ func (g *GameDebug) SetDebugQuery(debug bool, query *bson.M, dictionary *german.
 	g.Options = options
 }
 
-func (g *GameDebug) SetDebugResult(debug bool, right []string, english []entity.Meaning, third []entity.Meaning) {
+func (g *GameDebug) SetDebugResult(debug bool, right []string, lang string, meanings []entity.Meaning) {
 	if !debug {
 		return
 	}
 
 	g.Right = right
-	g.English = english
-	g.Third = third
+	g.Language = lang
+	g.Meanings = meanings
 }
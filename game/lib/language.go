@@ -0,0 +1,20 @@
+package game
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/peteraba/d5/lib/german/entity"
+)
+
+// GetLanguage returns the translation language chosen by the learner, sent as "lang" query or form parameter
+func GetLanguage(c *gin.Context) string {
+	raw := c.Query("lang")
+	if raw == "" {
+		raw = c.PostForm("lang")
+	}
+
+	if lang, ok := entity.NewLanguage(raw); ok {
+		return lang
+	}
+
+	return entity.DefaultLanguage
+}
@@ -3,10 +3,12 @@ package game
 import (
 	"fmt"
 	"log"
+	"os"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/peteraba/d5/lib/mongo"
+	"github.com/peteraba/d5/lib/play"
 	"github.com/peteraba/d5/lib/util"
 	"gopkg.in/mgo.v2"
 )
@@ -14,34 +16,51 @@ import (
 const usageBase = `
 %s supports CLI and Server mode.
 
-In CLI mode it expects input data on standard input, in server mode as raw POST body
+In CLI mode questions are asked and answers are read in the terminal, in server mode they are served over HTTP
 
 Usage:
-  %s [--server] [--port=<n>] [--debug] [--user=<s>] [--action=<s>] [--finder=<s>] [scorer=<s>]
+  %s [--server] [--port=<n>] [--debug] [--user=<s>] [--action=<s>] [--lang=<s>] [--script] [--remediation] [--finder=<s>] [--scorer=<s>] [--admin=<s>] [--url=<s>]
   %s -h | --help
   %s -v | --version
 
 Options:
-  -s, --server      run in server mode
-  -p, --port=<n>    port to open (server mode only) [default: %s]
-  -d, --debug       skip ticks and generate fake data concurrently
-  -u, --user=<s>    user the data belongs to (cli mode only)
-  -a, --action=<s>  action (cli mode only)
-  -f, --finder=<s>  url to use to access finder (optional)
-  -c, --scorer=<s>  url to use to access scorer (optional)
-  -v, --version     show version information
-  -h, --help        show help information
+  -s, --server       run in server mode
+  -p, --port=<n>     port to open (server mode only) [default: %s]
+  -d, --debug        skip ticks and generate fake data concurrently
+  -u, --user=<s>     user the data belongs to (cli mode only)
+  -a, --action=<s>   action: play, game or answer (cli mode only) [default: play]
+  -l, --lang=<s>     language of translations (cli mode only) [default: en]
+  -t, --script       read one answer per line from standard input and print results as JSON lines (cli mode only)
+  -r, --remediation  ask about the patterns of mistakes made before (cli mode only)
+  -f, --finder=<s>   url to use to access finder (optional)
+  -c, --scorer=<s>   url to use to access scorer (optional)
+  -m, --admin=<s>    url of admin to register the game with on startup (server mode only, optional)
+  -o, --url=<s>      url the game can be reached at, sent to admin (server mode only, http://localhost:<port>/ by default)
+  -v, --version      show version information
+  -h, --help         show help information
 
 API
+  - play
+    - CLI:  --action=play --user=john_doe
   - game
-    - CLI:  ?action=game&user=john_doe
+    - CLI:  --action=game --user=john_doe
     - HTTP: /game/john_doe
   - answer
-    - CLI:  ?action=answer&user=john_doe
+    - CLI:  --action=answer --user=john_doe (answer id and answer on the first two lines of standard input)
     - HTTP: /answer/john_doe
+  - meta
+    - HTTP: /meta (name, description, word categories required, options supported and version of the game)
+  - health
+    - HTTP: /health
+
+Translations are given in the language sent as lang parameter (e.g. /game/john_doe?lang=hu), English by default.
+Wrong answers are recorded in the mistake journal, questions are about the patterns of these mistakes if the
+remediation parameter is sent (e.g. /game/john_doe?remediation=1).
+Answers are responded with the score, the right answers and the examples and notes of the words asked.
 `
 
 type GameServer interface {
+	GetMeta() play.Meta
 	MakeGameHandle(finderUrl string, mgoCollection *mgo.Collection, isDebug bool) func(*gin.Context)
 	MakeCheckAnswerHandle(finderUrl, scorerUrl string, mgoCollection *mgo.Collection, isDebug bool) func(*gin.Context)
 }
@@ -58,36 +77,70 @@ func Main(name, version, defaultPort string, gameServer GameServer) {
 	mgoCollection := mgoDb.C(mongo.ParseResultCollection())
 
 	if isServer {
-		startServer(gameServer, port, mgoCollection, finderUrl, scorerUrl, isDebug)
+		adminUrl, _ := cliArguments["--admin"].(string)
+		gameUrl, _ := cliArguments["--url"].(string)
+		if gameUrl == "" {
+			gameUrl = fmt.Sprintf("http://localhost:%d/", port)
+		}
+
+		startServer(gameServer, port, mgoCollection, finderUrl, scorerUrl, adminUrl, gameUrl, isDebug)
 		return
 	}
 
 	user, _ := cliArguments["--user"].(string)
 	action, _ := cliArguments["--action"].(string)
-	serveCli(gameServer, mgoCollection, user, action, finderUrl, scorerUrl, isDebug)
+	lang, _ := cliArguments["--lang"].(string)
+	isScript, _ := cliArguments["--script"].(bool)
+	isRemediation, _ := cliArguments["--remediation"].(bool)
+	serveCli(gameServer, mgoCollection, user, action, lang, finderUrl, scorerUrl, isDebug, isScript, isRemediation)
 }
 
 /**
  * CLI
  */
 
-func serveCli(gameServer GameServer, mgoCollection *mgo.Collection, user, action, finderUrl, scorerUrl string, isDebug bool) {
-	log.Println("CLI support is not available yet.")
+func serveCli(gameServer GameServer, mgoCollection *mgo.Collection, user, action, lang, finderUrl, scorerUrl string, isDebug, isScript, isRemediation bool) {
+	if user == "" {
+		log.Fatalln("User is required in CLI mode.")
+	}
+
+	gin.SetMode(gin.ReleaseMode)
+
+	router := gin.New()
+	router.Use(gin.Recovery())
+	addRoutes(router, gameServer, mgoCollection, finderUrl, scorerUrl, isDebug)
+
+	err := runCli(newCliClient(router, user, lang, isRemediation), action, isScript, os.Stdin, os.Stdout)
+	if err != nil {
+		log.Fatalln(err)
+	}
 }
 
 /**
  * SERVER
  */
 
-func startServer(gameServer GameServer, port int, mgoCollection *mgo.Collection, finderUrl, scorerUrl string, isDebug bool) {
+func startServer(gameServer GameServer, port int, mgoCollection *mgo.Collection, finderUrl, scorerUrl, adminUrl, gameUrl string, isDebug bool) {
 	if !isDebug {
 		gin.SetMode(gin.ReleaseMode)
 	}
 
 	router := gin.Default()
+	addRoutes(router, gameServer, mgoCollection, finderUrl, scorerUrl, isDebug)
 
-	router.GET("/game/:user", gameServer.MakeGameHandle(finderUrl, mgoCollection, isDebug))
-	router.POST("/answer/:user", gameServer.MakeCheckAnswerHandle(finderUrl, scorerUrl, mgoCollection, isDebug))
+	if adminUrl != "" {
+		meta := GetMeta(gameServer)
+		meta.Url = gameUrl
+
+		go register(adminUrl, meta)
+	}
 
 	router.Run(fmt.Sprintf(":%d", port))
 }
+
+func addRoutes(router *gin.Engine, gameServer GameServer, mgoCollection *mgo.Collection, finderUrl, scorerUrl string, isDebug bool) {
+	router.GET("/game/:user", gameServer.MakeGameHandle(finderUrl, mgoCollection, isDebug))
+	router.POST("/answer/:user", gameServer.MakeCheckAnswerHandle(finderUrl, scorerUrl, mgoCollection, isDebug))
+	router.GET("/meta", makeMetaHandle(gameServer))
+	router.GET("/health", makeHealthHandle(gameServer, mgoCollection))
+}
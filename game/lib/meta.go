@@ -0,0 +1,80 @@
+package game
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/peteraba/d5/lib/german/entity"
+	"github.com/peteraba/d5/lib/play"
+	"gopkg.in/mgo.v2"
+)
+
+const (
+	// registerAttempts is the number of times registering with admin is tried on startup
+	registerAttempts = 5
+	// registerInterval is the time waited between registration attempts
+	registerInterval = 10 * time.Second
+)
+
+// commonOptions are the query parameters supported by every game
+var commonOptions = []play.Option{
+	{Name: "lang", Description: "language of translations", Default: entity.DefaultLanguage},
+	{Name: "remediation", Description: "ask about the patterns of mistakes made before"},
+}
+
+// GetMeta returns the description of a game together with the options supported by every game
+func GetMeta(gameServer GameServer) play.Meta {
+	meta := gameServer.GetMeta()
+	meta.Options = append(append([]play.Option{}, commonOptions...), meta.Options...)
+
+	return meta
+}
+
+func register(adminUrl string, meta play.Meta) {
+	var err error
+
+	for i := 0; i < registerAttempts; i++ {
+		err = play.Register(adminUrl, meta)
+		if err == nil {
+			log.Printf("Registered %s with admin at %s\n", meta.Name, adminUrl)
+
+			return
+		}
+
+		time.Sleep(registerInterval)
+	}
+
+	log.Printf("Registering %s with admin failed: %v\n", meta.Name, err)
+}
+
+func makeMetaHandle(gameServer GameServer) func(c *gin.Context) {
+	meta := GetMeta(gameServer)
+
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, meta)
+	}
+}
+
+// makeHealthHandle reports a game healthy as long as its database can be reached
+func makeHealthHandle(gameServer GameServer, mgoCollection *mgo.Collection) func(c *gin.Context) {
+	meta := gameServer.GetMeta()
+
+	return func(c *gin.Context) {
+		health := play.Health{Name: meta.Name, Version: meta.Version, Status: play.StatusOk}
+
+		err := mgoCollection.Database.Session.Ping()
+		if err != nil {
+			health.Status = play.StatusDown
+			health.Error = fmt.Sprint(err)
+
+			c.JSON(http.StatusServiceUnavailable, health)
+
+			return
+		}
+
+		c.JSON(http.StatusOK, health)
+	}
+}
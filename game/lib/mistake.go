@@ -0,0 +1,104 @@
+package game
+
+import (
+	"log"
+
+	"github.com/gin-gonic/gin"
+	"github.com/peteraba/d5/lib/german"
+	"github.com/peteraba/d5/lib/german/entity"
+	"github.com/peteraba/d5/lib/mistake"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// SaveMistake records a wrong answer in the mistake journal of the user, right answers are skipped
+func SaveMistake(mgoCollection *mgo.Collection, user string, score int, event ScoreEvent, wordIds []string, words ...entity.Word) {
+	if !mistake.IsMistake(score) {
+		return
+	}
+
+	m := mistake.NewMistake(user, event.Game, event.QuestionType, event.Answer, event.Right, score, wordIds, words...)
+
+	err := mistake.Save(mistake.GetCollection(mgoCollection.Database), m)
+	if err != nil {
+		log.Printf("Saving mistake failed: %v\n", err)
+	}
+}
+
+// IsRemediation checks if the questions should be about the mistakes made before (e.g. /game/john_doe?remediation=1)
+func IsRemediation(c *gin.Context) bool {
+	return c.Query("remediation") != ""
+}
+
+// AddRemediation narrows the query to one of the patterns the user made mistakes in, frequent patterns are chosen more often
+func AddRemediation(c *gin.Context, mgoCollection *mgo.Collection, gameName string, query bson.M) (mistake.Group, bool) {
+	group, ok, _ := addRemediation(c, mgoCollection, gameName, query)
+
+	return group, ok
+}
+
+// FetchRemediatedWords fetches words of the query narrowed to a mistake pattern, if no words match the pattern any more
+// (e.g. they were deleted) the words of the query are fetched without it
+func FetchRemediatedWords(c *gin.Context, finderUrl string, mgoCollection *mgo.Collection, gameName string, query bson.M, limit int) ([]entity.Word, int, error) {
+	_, _, keys := addRemediation(c, mgoCollection, gameName, query)
+
+	words, returnCode, err := FetchWords(finderUrl, query, limit)
+	if len(keys) == 0 || returnCode != 204 {
+		return words, returnCode, err
+	}
+
+	removeKeys(query, keys)
+
+	return FetchWords(finderUrl, query, limit)
+}
+
+// FetchRemediatedDictionary fetches a dictionary of the query narrowed to a mistake pattern, if no words match the
+// pattern any more the words of the query are fetched without it
+func FetchRemediatedDictionary(c *gin.Context, finderUrl string, mgoCollection *mgo.Collection, gameName string, query bson.M, limit int) (german.Dictionary, int, error) {
+	_, _, keys := addRemediation(c, mgoCollection, gameName, query)
+
+	dictionary, returnCode, err := FetchDictionary(finderUrl, query, limit)
+	if len(keys) == 0 || err != nil || dictionary.GetCount() > 0 {
+		return dictionary, returnCode, err
+	}
+
+	removeKeys(query, keys)
+
+	return FetchDictionary(finderUrl, query, limit)
+}
+
+// addRemediation narrows the query to a mistake pattern and returns the keys added to the query
+func addRemediation(c *gin.Context, mgoCollection *mgo.Collection, gameName string, query bson.M) (mistake.Group, bool, []string) {
+	keys := []string{}
+
+	if !IsRemediation(c) {
+		return mistake.Group{}, false, keys
+	}
+
+	mistakes, err := mistake.Find(mistake.GetCollection(mgoCollection.Database), c.Param("user"), gameName)
+	if err != nil {
+		log.Printf("Finding mistakes failed: %v\n", err)
+
+		return mistake.Group{}, false, keys
+	}
+
+	group, ok := mistake.PickGroup(mistake.GroupMistakes(mistakes))
+	if !ok {
+		return mistake.Group{}, false, keys
+	}
+
+	for key, value := range group.GetQuery() {
+		if _, ok := query[key]; !ok {
+			query[key] = value
+			keys = append(keys, key)
+		}
+	}
+
+	return group, true, keys
+}
+
+func removeKeys(query bson.M, keys []string) {
+	for _, key := range keys {
+		delete(query, key)
+	}
+}
@@ -4,12 +4,14 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"time"
 
 	"gopkg.in/mgo.v2"
 )
 
-func SaveAnswer(id string, wordIds, right []string, mgoCollection *mgo.Collection) error {
+// SaveAnswer stores the right answers of a question, the question type is passed on to the scorer with the answer
+func SaveAnswer(id, questionType string, wordIds, right []string, mgoCollection *mgo.Collection) error {
 	var (
 		err     error
 		tracker Tracker
@@ -17,6 +19,7 @@ func SaveAnswer(id string, wordIds, right []string, mgoCollection *mgo.Collectio
 
 	tracker = Tracker{}
 	tracker.Id = id
+	tracker.QuestionType = questionType
 	tracker.WordIds = wordIds
 	tracker.Right = right
 	tracker.CreatedAt = time.Now()
@@ -26,7 +29,8 @@ func SaveAnswer(id string, wordIds, right []string, mgoCollection *mgo.Collectio
 	return err
 }
 
-func FindAnswer(id string, mgoCollection *mgo.Collection) (Tracker, error) {
+// FindAnswer loads the right answers of a question, questions unknown or expired are not found
+func FindAnswer(id string, mgoCollection *mgo.Collection) (Tracker, int, error) {
 	var (
 		trackers = []Tracker{}
 		err      error
@@ -37,7 +41,7 @@ func FindAnswer(id string, mgoCollection *mgo.Collection) (Tracker, error) {
 	if err != nil {
 		log.Printf("Error while finding answer with id: %s, err: %v\n", id, err)
 
-		return Tracker{}, err
+		return Tracker{}, http.StatusInternalServerError, err
 	}
 
 	if len(trackers) < 1 {
@@ -45,8 +49,8 @@ func FindAnswer(id string, mgoCollection *mgo.Collection) (Tracker, error) {
 
 		log.Println(errorMsg)
 
-		return Tracker{}, errors.New(errorMsg)
+		return Tracker{}, http.StatusNotFound, errors.New(errorMsg)
 	}
 
-	return trackers[0], nil
+	return trackers[0], http.StatusOK, nil
 }
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/gin-gonic/gin"
+	game "github.com/peteraba/d5/game/lib"
+	"github.com/peteraba/d5/game/numbers/lib"
+	"github.com/peteraba/d5/lib/german/entity"
+	"github.com/peteraba/d5/lib/play"
+	"github.com/peteraba/d5/lib/util"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+const name = "Numbers"
+const version = "0.1"
+const defaultPort = "10530"
+
+type Numbers struct{}
+
+/**
+ * MAIN
+ */
+
+func main() {
+	gameServer := Numbers{}
+	game.Main(name, version, defaultPort, gameServer)
+}
+
+// GetMeta describes the game for admin
+func (n Numbers) GetMeta() play.Meta {
+	return play.Meta{
+		Name:          name,
+		Description:   "Numbers, dates and clock times game: numbers written in words or in digits",
+		RequiresWords: false,
+		Options: []play.Option{
+			{Name: "drill", Description: "kind of numbers asked, random by default", Values: lib.Drills},
+			{Name: "direction", Description: "form of the answer, random by default", Values: lib.Directions},
+		},
+		Version: version,
+	}
+}
+
+/**
+ * DOMAIN
+ */
+
+// MakeGameHandle generates the question, the user's dictionary is not needed so the finder is never called
+func (n Numbers) MakeGameHandle(finderUrl string, mgoCollection *mgo.Collection, debug bool) func(c *gin.Context) {
+	return func(c *gin.Context) {
+		gameAnswer := game.GameAnswer{}
+
+		lang := game.GetLanguage(c)
+		drillName := n.getParam(c, "drill", lib.Drills)
+		direction := n.getParam(c, "direction", lib.Directions)
+
+		if group, ok := game.AddRemediation(c, mgoCollection, name, bson.M{}); ok {
+			drillName, direction = n.getRemediation(group.QuestionType, drillName, direction)
+		}
+
+		drill := lib.NewDrill(drillName, direction)
+		if len(drill.Right) == 0 {
+			gameAnswer.Error = "No right answer found"
+
+			c.JSON(200, gameAnswer)
+
+			return
+		}
+
+		gameAnswer.Question = drill.Question
+		gameAnswer.Id = util.GenerateUid()
+
+		gameAnswer.SetDebugQuery(debug, nil, nil, nil, &bson.M{"drill": drill.Name, "direction": drill.Direction})
+		gameAnswer.SetDebugResult(debug, drill.Right, lang, nil)
+
+		err := game.SaveAnswer(gameAnswer.GetId(), drill.GetQuestionType(), []string{}, drill.Right, mgoCollection)
+		if err != nil {
+			gameAnswer.Error = fmt.Sprint(err)
+		}
+
+		c.JSON(200, gameAnswer)
+	}
+}
+
+// getParam returns the parameter if it is one of the options, a random option is chosen by default
+func (n Numbers) getParam(c *gin.Context, key string, options []string) string {
+	value := c.Query(key)
+	if util.StringIn(value, options) {
+		return value
+	}
+
+	return options[rand.Intn(len(options))]
+}
+
+// getRemediation returns the drill and direction of a question type mistakes were made in, the ones given by default
+func (n Numbers) getRemediation(questionType, drillName, direction string) (string, string) {
+	remediationDrill, remediationDirection, ok := lib.ParseQuestionType(questionType)
+	if !ok {
+		return drillName, direction
+	}
+
+	return remediationDrill, remediationDirection
+}
+
+func (n Numbers) MakeCheckAnswerHandle(finderUrl, scorerUrl string, mgoCollection *mgo.Collection, debug bool) func(c *gin.Context) {
+	return game.MakeCheckAnswerHandle(name, finderUrl, scorerUrl, mgoCollection, n.gradeAnswer)
+}
+
+// gradeAnswer grades the number typed by the drill of the question
+func (n Numbers) gradeAnswer(tracker game.Tracker, answer string, words []entity.Word) (game.Answer, error) {
+	drill, direction, _ := lib.ParseQuestionType(tracker.QuestionType)
+
+	return game.Answer{Answer: answer, Score: lib.Grade(answer, tracker.Right, drill, direction), Right: tracker.Right}, nil
+}
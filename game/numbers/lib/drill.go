@@ -0,0 +1,228 @@
+package lib
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/peteraba/d5/lib/german/grade"
+	"github.com/peteraba/d5/lib/german/numeral"
+	"github.com/peteraba/d5/lib/util"
+)
+
+const (
+	DrillCardinal = "cardinal"
+	DrillOrdinal  = "ordinal"
+	DrillDate     = "date"
+	DrillClock    = "clock"
+)
+
+const (
+	DirectionWords  = "words"
+	DirectionDigits = "digits"
+)
+
+var (
+	Drills     = []string{DrillCardinal, DrillOrdinal, DrillDate, DrillClock}
+	Directions = []string{DirectionWords, DirectionDigits}
+
+	// cardinalLimits are the upper limits of the ranges cardinal numbers are picked from, smaller numbers come up more often
+	cardinalLimits = []int{20, 100, 100, 1000, 10000}
+
+	compactReplacer = strings.NewReplacer(" ", "", "-", "")
+	answerReplacer  = strings.NewReplacer("ä", "ae", "ö", "oe", "ü", "ue", "ß", "ss")
+
+	// answers are the normalized right answers in words of every question of each drill, collected once when needed
+	answers     = map[string]map[string]bool{}
+	answersOnce sync.Once
+)
+
+// maxOrdinal is the largest ordinal number asked
+const maxOrdinal = 1000
+
+// Drill is a generated question with its accepted answers
+type Drill struct {
+	Name      string
+	Direction string
+	Question  string
+	Right     []string
+}
+
+// NewDrill generates a question of a drill type, direction tells if the answer is expected in words or digits
+func NewDrill(name, direction string) Drill {
+	switch name {
+	case DrillOrdinal:
+		return newOrdinalDrill(direction)
+	case DrillDate:
+		return newDateDrill(direction)
+	case DrillClock:
+		return newClockDrill(direction)
+	}
+
+	return newCardinalDrill(direction)
+}
+
+func newCardinalDrill(direction string) Drill {
+	n := rand.Intn(cardinalLimits[rand.Intn(len(cardinalLimits))])
+
+	if direction == DirectionDigits {
+		return Drill{DrillCardinal, direction, fmt.Sprintf("Which number is '%s'?", numeral.Cardinal(n)), []string{fmt.Sprint(n)}}
+	}
+
+	return Drill{DrillCardinal, direction, fmt.Sprintf("How do you write %d in words?", n), numeral.Cardinals(n)}
+}
+
+func newOrdinalDrill(direction string) Drill {
+	n := rand.Intn(100) + 1
+	if rand.Int31n(5) == 0 {
+		n = rand.Intn(maxOrdinal) + 1
+	}
+
+	if direction == DirectionDigits {
+		right := []string{fmt.Sprintf("%d.", n), fmt.Sprint(n), fmt.Sprintf("der %d.", n)}
+
+		return Drill{DrillOrdinal, direction, fmt.Sprintf("Which ordinal number is '%s'?", numeral.Ordinal(n)), right}
+	}
+
+	right := []string{numeral.Ordinal(n), numeral.OrdinalStem(n) + "e"}
+
+	return Drill{DrillOrdinal, direction, fmt.Sprintf("How do you write 'der %d.' in words?", n), right}
+}
+
+func newDateDrill(direction string) Drill {
+	month := rand.Intn(len(numeral.Months)) + 1
+	day := rand.Intn(daysIn(month)) + 1
+	monthName := numeral.Months[month-1]
+
+	if direction == DirectionDigits {
+		right := []string{
+			fmt.Sprintf("%d.%d.", day, month),
+			fmt.Sprintf("%02d.%02d.", day, month),
+			fmt.Sprintf("%d.%d", day, month),
+			fmt.Sprintf("%d. %s", day, monthName),
+			fmt.Sprintf("am %d.%d.", day, month),
+			fmt.Sprintf("am %d. %s", day, monthName),
+		}
+
+		return Drill{DrillDate, direction, fmt.Sprintf("Which date is '%s'?", numeral.Date(day, month)[0]), right}
+	}
+
+	return Drill{DrillDate, direction, fmt.Sprintf("How do you say 'am %d. %s' (am %d.%d.) in words?", day, monthName, day, month), numeral.Date(day, month)}
+}
+
+func newClockDrill(direction string) Drill {
+	hour := rand.Intn(24)
+	minute := rand.Intn(12) * 5
+
+	if direction == DirectionDigits {
+		question := fmt.Sprintf("What time is '%s'?", numeral.ClockTimes(hour, minute)[0])
+
+		return Drill{DrillClock, direction, question, numeral.DigitalTimes(hour, minute)}
+	}
+
+	return Drill{DrillClock, direction, fmt.Sprintf("How do you say %d:%02d in colloquial German?", hour, minute), numeral.ClockTimes(hour, minute)}
+}
+
+// daysIn returns the number of days of a month in a leap year
+func daysIn(month int) int {
+	return time.Date(2000, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// GetQuestionType returns the drill and the direction of the question, stored with the answer
+func (d Drill) GetQuestionType() string {
+	return d.Name + " " + d.Direction
+}
+
+// ParseQuestionType returns the drill and the direction of a question type, false for unknown question types
+func ParseQuestionType(questionType string) (string, string, bool) {
+	parts := strings.Fields(questionType)
+	if len(parts) != 2 || !util.StringIn(parts[0], Drills) || !util.StringIn(parts[1], Directions) {
+		return "", "", false
+	}
+
+	return parts[0], parts[1], true
+}
+
+// Grade grades the answer, digits have to match exactly (month names are not case sensitive), numbers written in
+// words get the usual partial scores for typos unless the answer is the right answer of another question of the drill,
+// spaces and hyphens inside them are ignored
+func Grade(answer string, right []string, drill, direction string) int {
+	compactAnswer := compactReplacer.Replace(strings.TrimSpace(answer))
+
+	compactRight := []string{}
+	for _, r := range right {
+		compact := compactReplacer.Replace(r)
+		if !util.StringIn(compact, compactRight) {
+			compactRight = append(compactRight, compact)
+		}
+	}
+
+	if direction == DirectionDigits {
+		for _, r := range compactRight {
+			if compactAnswer != "" && strings.EqualFold(compactAnswer, r) {
+				return grade.ScoreRight
+			}
+		}
+
+		return grade.ScoreWrong
+	}
+
+	score := grade.Grade(answer, right)
+	if compactScore := grade.Grade(compactAnswer, compactRight); compactScore > score {
+		score = compactScore
+	}
+
+	if score < grade.ScoreCapitalization && isOtherAnswer(compactAnswer, drill) {
+		return grade.ScoreWrong
+	}
+
+	return score
+}
+
+// isOtherAnswer checks if an answer written in words is right for another question of the drill, e.g. "zweiundzwanzig"
+// for 23 is a different number, not a typo
+func isOtherAnswer(compactAnswer, drill string) bool {
+	answersOnce.Do(collectAnswers)
+
+	return answers[drill][normalizeAnswer(compactAnswer)]
+}
+
+// normalizeAnswer makes answers in words comparable: lower case, without spaces, hyphens and umlauts
+func normalizeAnswer(answer string) string {
+	return answerReplacer.Replace(strings.ToLower(compactReplacer.Replace(answer)))
+}
+
+// collectAnswers generates the right answers in words of all questions the drills can ask
+func collectAnswers() {
+	add := func(drill string, right ...string) {
+		if answers[drill] == nil {
+			answers[drill] = map[string]bool{}
+		}
+
+		for _, r := range right {
+			answers[drill][normalizeAnswer(r)] = true
+		}
+	}
+
+	for n := 0; n < cardinalLimits[len(cardinalLimits)-1]; n++ {
+		add(DrillCardinal, numeral.Cardinals(n)...)
+	}
+
+	for n := 1; n <= maxOrdinal; n++ {
+		add(DrillOrdinal, numeral.Ordinal(n), numeral.OrdinalStem(n)+"e")
+	}
+
+	for month := 1; month <= len(numeral.Months); month++ {
+		for day := 1; day <= daysIn(month); day++ {
+			add(DrillDate, numeral.Date(day, month)...)
+		}
+	}
+
+	for hour := 0; hour < 24; hour++ {
+		for minute := 0; minute < 60; minute += 5 {
+			add(DrillClock, numeral.ClockTimes(hour, minute)...)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package lib
+
+import (
+	"testing"
+
+	"github.com/peteraba/d5/lib/german/grade"
+	"github.com/peteraba/d5/lib/german/numeral"
+)
+
+var gradeCases = []struct {
+	answer    string
+	right     []string
+	drill     string
+	direction string
+	expected  int
+}{
+	{"1234", []string{"1234"}, DrillCardinal, DirectionDigits, grade.ScoreRight},
+	{" 1234 ", []string{"1234"}, DrillCardinal, DirectionDigits, grade.ScoreRight},
+	{"1235", []string{"1234"}, DrillCardinal, DirectionDigits, grade.ScoreWrong},
+	{"12345", []string{"1234"}, DrillCardinal, DirectionDigits, grade.ScoreWrong},
+	{"", []string{"1234"}, DrillCardinal, DirectionDigits, grade.ScoreWrong},
+	{"15:30", numeral.DigitalTimes(15, 30), DrillClock, DirectionDigits, grade.ScoreRight},
+	{"3.30", numeral.DigitalTimes(15, 30), DrillClock, DirectionDigits, grade.ScoreRight},
+	{"15:35", numeral.DigitalTimes(15, 30), DrillClock, DirectionDigits, grade.ScoreWrong},
+	{"16:30", numeral.DigitalTimes(15, 30), DrillClock, DirectionDigits, grade.ScoreWrong},
+	{"am 12. mai", []string{"12.5.", "am 12. Mai"}, DrillDate, DirectionDigits, grade.ScoreRight},
+	{"12.6.", []string{"12.5.", "am 12. Mai"}, DrillDate, DirectionDigits, grade.ScoreWrong},
+	{"dreiundzwanzig", numeral.Cardinals(23), DrillCardinal, DirectionWords, grade.ScoreRight},
+	{"drei-und zwanzig", numeral.Cardinals(23), DrillCardinal, DirectionWords, grade.ScoreRight},
+	{"Dreiundzwanzig", numeral.Cardinals(23), DrillCardinal, DirectionWords, grade.ScoreCapitalization},
+	{"zweiundzwanzig", numeral.Cardinals(23), DrillCardinal, DirectionWords, grade.ScoreWrong},
+	{"dreiundzwanzg", numeral.Cardinals(23), DrillCardinal, DirectionWords, grade.ScoreTypo},
+	{"dreissig", numeral.Cardinals(30), DrillCardinal, DirectionWords, grade.ScoreRight},
+	{"dreisig", numeral.Cardinals(30), DrillCardinal, DirectionWords, grade.ScoreTypo},
+	{"dreizehn", numeral.Cardinals(30), DrillCardinal, DirectionWords, grade.ScoreWrong},
+	{"einundzwanzigte", []string{numeral.Ordinal(21), numeral.OrdinalStem(21) + "e"}, DrillOrdinal, DirectionWords, grade.ScoreTypo},
+	{"der einundzwnzigte", []string{numeral.Ordinal(21), numeral.OrdinalStem(21) + "e"}, DrillOrdinal, DirectionWords, grade.ScoreTwoTypos},
+	{"zweiundzwanzigste", []string{numeral.Ordinal(21), numeral.OrdinalStem(21) + "e"}, DrillOrdinal, DirectionWords, grade.ScoreWrong},
+	{numeral.ClockTimes(15, 15)[0], numeral.ClockTimes(14, 15), DrillClock, DirectionWords, grade.ScoreWrong},
+	{numeral.Date(3, 5)[0], numeral.Date(2, 5), DrillDate, DirectionWords, grade.ScoreWrong},
+	{"am dritten Maj", numeral.Date(3, 5), DrillDate, DirectionWords, grade.ScoreTypo},
+}
+
+func TestGrade(t *testing.T) {
+	for num, testCase := range gradeCases {
+		actual := Grade(testCase.answer, testCase.right, testCase.drill, testCase.direction)
+
+		if actual != testCase.expected {
+			t.Fatalf("Case #%d: Expected score %d for '%s', got: %d", num+1, testCase.expected, testCase.answer, actual)
+		}
+	}
+
+	t.Log(len(gradeCases), "test cases")
+}
+
+func TestParseQuestionType(t *testing.T) {
+	cases := []struct {
+		questionType string
+		drill        string
+		direction    string
+		ok           bool
+	}{
+		{NewDrill(DrillClock, DirectionDigits).GetQuestionType(), DrillClock, DirectionDigits, true},
+		{"date words", DrillDate, DirectionWords, true},
+		{"date", "", "", false},
+		{"article words", "", "", false},
+	}
+
+	for num, testCase := range cases {
+		drill, direction, ok := ParseQuestionType(testCase.questionType)
+
+		if drill != testCase.drill || direction != testCase.direction || ok != testCase.ok {
+			t.Fatalf("Case #%d: Unexpected result for '%s': %s, %s, %v", num+1, testCase.questionType, drill, direction, ok)
+		}
+	}
+
+	t.Log(len(cases), "test cases")
+}
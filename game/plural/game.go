@@ -0,0 +1,198 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/gin-gonic/gin"
+	game "github.com/peteraba/d5/game/lib"
+	"github.com/peteraba/d5/lib/german/entity"
+	"github.com/peteraba/d5/lib/german/grade"
+	"github.com/peteraba/d5/lib/play"
+	"github.com/peteraba/d5/lib/util"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+const name = "Plural"
+const version = "0.1"
+const defaultPort = "10470"
+
+const (
+	modePlural   = "plural"
+	modeGenitive = "genitive"
+	modeSingular = "singular"
+)
+
+// noSingular lists the accepted answers for nouns used only in plural
+var noSingular = []string{"no singular", "-"}
+
+type Plural struct{}
+
+/**
+ * MAIN
+ */
+
+func main() {
+	gameServer := Plural{}
+	game.Main(name, version, defaultPort, gameServer)
+}
+
+// GetMeta describes the game for admin
+func (p Plural) GetMeta() play.Meta {
+	return play.Meta{
+		Name:          name,
+		Description:   "Plural and genitive game: the plural, the genitive singular or the singular of a noun",
+		Categories:    []string{entity.CategoryNoun},
+		RequiresWords: true,
+		Version:       version,
+	}
+}
+
+/**
+ * DOMAIN
+ */
+
+func (p Plural) MakeGameHandle(finderUrl string, mgoCollection *mgo.Collection, debug bool) func(c *gin.Context) {
+	return func(c *gin.Context) {
+		var (
+			noun       *entity.Noun
+			query      = bson.M{}
+			gameAnswer = game.GameAnswer{}
+		)
+
+		lang := game.GetLanguage(c)
+
+		query["word.category"] = entity.CategoryNoun
+		query["word.user"] = c.Param("user")
+
+		dictionary, returnCode, err := game.FetchRemediatedDictionary(c, finderUrl, mgoCollection, name, query, 1)
+		if err != nil {
+			c.JSON(returnCode, fmt.Sprint(err))
+
+			return
+		}
+
+		if len(dictionary.Nouns) > 0 && len(dictionary.Nouns[0].Articles) > 0 {
+			noun = &dictionary.Nouns[0]
+		}
+
+		if noun == nil {
+			gameAnswer.Error = "No nouns found"
+			gameAnswer.SetDebugQuery(debug, &query, &dictionary, nil, nil)
+
+			c.JSON(200, gameAnswer)
+
+			return
+		}
+
+		mode := p.getMode(noun)
+		right := p.getRight(noun, mode)
+
+		gameAnswer.Question = p.getQuestion(noun, mode)
+		gameAnswer.Id = util.GenerateUid()
+
+		gameAnswer.SetDebugQuery(debug, &query, nil, noun, &bson.M{"mode": mode})
+		gameAnswer.SetDebugResult(debug, right, lang, noun.GetMeanings(lang))
+
+		err = game.SaveAnswer(gameAnswer.GetId(), mode, []string{noun.GetId().Hex()}, right, mgoCollection)
+		if err != nil {
+			gameAnswer.Error = fmt.Sprint(err)
+		}
+
+		c.JSON(200, gameAnswer)
+	}
+}
+
+// getMode asks plural only nouns for their singular, nouns without plural for their genitive
+func (p Plural) getMode(noun *entity.Noun) string {
+	if noun.IsPluralOnly {
+		return modeSingular
+	}
+
+	if len(p.getPlurals(noun)) == 0 || rand.Int31n(3) == 0 {
+		return modeGenitive
+	}
+
+	return modePlural
+}
+
+func (p Plural) getPlurals(noun *entity.Noun) []string {
+	plurals := []string{}
+
+	for _, plural := range noun.GetPlurals() {
+		if plural != "" {
+			plurals = append(plurals, plural)
+		}
+	}
+
+	return plurals
+}
+
+func (p Plural) getQuestion(noun *entity.Noun, mode string) string {
+	article := entity.DefiniteArticle("der", noun.Articles[0], noun.IsPluralOnly, entity.CaseNominative)
+
+	switch mode {
+	case modeSingular:
+		return fmt.Sprintf("What's the singular of '%s %s'?", article, noun.GetGerman())
+	case modeGenitive:
+		return fmt.Sprintf("What's the genitive singular of '%s %s'?", article, noun.GetGerman())
+	}
+
+	return fmt.Sprintf("What's the plural of '%s %s'?", article, noun.GetGerman())
+}
+
+// getRight accepts the forms with or without article
+func (p Plural) getRight(noun *entity.Noun, mode string) []string {
+	var right = []string{}
+
+	switch mode {
+	case modeSingular:
+		return noSingular
+	case modeGenitive:
+		right = noun.DeclineWithArticle("der", true, false, entity.CaseGenitive)
+
+		return append(right, noun.Decline(false, entity.CaseGenitive)...)
+	}
+
+	for _, plural := range p.getPlurals(noun) {
+		right = append(right, plural, "die "+plural)
+	}
+
+	return right
+}
+
+func (p Plural) MakeCheckAnswerHandle(finderUrl, scorerUrl string, mgoCollection *mgo.Collection, debug bool) func(c *gin.Context) {
+	return game.MakeCheckAnswerHandle(name, finderUrl, scorerUrl, mgoCollection, p.gradeAnswer)
+}
+
+// gradeAnswer grades the plural typed, the plural pattern of the noun asked is explained
+func (p Plural) gradeAnswer(tracker game.Tracker, answer string, words []entity.Word) (game.Answer, error) {
+	return game.Answer{
+		Answer:      answer,
+		Score:       grade.GradeStrict(answer, tracker.Right),
+		Right:       tracker.Right,
+		Explanation: p.getExplanation(words, tracker.Right),
+	}, nil
+}
+
+// getExplanation explains the rule of plurals following a standard pattern, the plural being among the right answers means the plural was asked
+func (p Plural) getExplanation(words []entity.Word, right []string) string {
+	for _, word := range words {
+		noun, ok := word.(*entity.Noun)
+		if !ok {
+			continue
+		}
+
+		plurals := p.getPlurals(noun)
+		if len(plurals) == 0 || !util.StringIn(plurals[0], right) {
+			continue
+		}
+
+		if rule, ok := entity.GetPluralRule(noun); ok {
+			return rule.Description
+		}
+	}
+
+	return ""
+}
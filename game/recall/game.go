@@ -0,0 +1,133 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	game "github.com/peteraba/d5/game/lib"
+	"github.com/peteraba/d5/lib/german/entity"
+	"github.com/peteraba/d5/lib/german/grade"
+	"github.com/peteraba/d5/lib/play"
+	"github.com/peteraba/d5/lib/util"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+const name = "Recall"
+const version = "0.1"
+const defaultPort = "10440"
+
+type Recall struct{}
+
+/**
+ * MAIN
+ */
+
+func main() {
+	gameServer := Recall{}
+	game.Main(name, version, defaultPort, gameServer)
+}
+
+// GetMeta describes the game for admin
+func (r Recall) GetMeta() play.Meta {
+	return play.Meta{
+		Name:          name,
+		Description:   "Free recall game: a word typed from its meaning",
+		RequiresWords: true,
+		Version:       version,
+	}
+}
+
+/**
+ * DOMAIN
+ */
+
+func (r Recall) MakeGameHandle(finderUrl string, mgoCollection *mgo.Collection, debug bool) func(c *gin.Context) {
+	return func(c *gin.Context) {
+		var (
+			words      []entity.Word
+			word       entity.Word
+			query      = bson.M{}
+			err        error
+			returnCode int
+		)
+
+		lang := game.GetLanguage(c)
+
+		query["word.user"] = c.Param("user")
+
+		words, returnCode, err = game.FetchRemediatedWords(c, finderUrl, mgoCollection, name, query, 1)
+		if err != nil {
+			c.JSON(returnCode, fmt.Sprint(err))
+
+			return
+		}
+
+		word = words[0]
+
+		gameAnswer, right := r.getGameAnswer(word, lang)
+
+		gameAnswer.SetDebugQuery(debug, &query, nil, word, nil)
+		gameAnswer.SetDebugResult(debug, right, lang, word.GetMeanings(lang))
+
+		if gameAnswer.Error == "" {
+			err = game.SaveAnswer(gameAnswer.GetId(), word.GetCategory(), []string{word.GetId().Hex()}, right, mgoCollection)
+			if err != nil {
+				gameAnswer.Error = fmt.Sprint(err)
+			}
+		}
+
+		c.JSON(200, gameAnswer)
+	}
+}
+
+func (r Recall) getGameAnswer(word entity.Word, lang string) (game.GameAnswer, []string) {
+	var (
+		gameAnswer = game.GameAnswer{}
+		meanings   = word.GetMeanings(lang)
+		mains      = []string{}
+	)
+
+	if len(meanings) == 0 {
+		gameAnswer.Error = "No meaning found"
+
+		return gameAnswer, []string{}
+	}
+
+	for _, meaning := range meanings {
+		mains = append(mains, meaning.String())
+	}
+
+	gameAnswer.Id = util.GenerateUid()
+
+	if noun, ok := word.(*entity.Noun); ok {
+		gameAnswer.Question = fmt.Sprintf("What's the German for '%s'? (with article)", strings.Join(mains, "; "))
+
+		return gameAnswer, r.getNounRight(noun)
+	}
+
+	gameAnswer.Question = fmt.Sprintf("What's the German for '%s'?", strings.Join(mains, "; "))
+
+	return gameAnswer, []string{word.GetGerman()}
+}
+
+// getNounRight returns the noun with each of its articles, plural only nouns are asked in plural
+func (r Recall) getNounRight(noun *entity.Noun) []string {
+	right := []string{}
+
+	for _, article := range noun.Articles {
+		right = append(right, entity.DefiniteArticle("der", article, noun.IsPluralOnly, entity.CaseNominative)+" "+noun.GetGerman())
+	}
+
+	return right
+}
+
+func (r Recall) MakeCheckAnswerHandle(finderUrl, scorerUrl string, mgoCollection *mgo.Collection, debug bool) func(c *gin.Context) {
+	return game.MakeCheckAnswerHandle(name, finderUrl, scorerUrl, mgoCollection, r.gradeAnswer)
+}
+
+// gradeAnswer grades the word typed, typos are tolerated
+func (r Recall) gradeAnswer(tracker game.Tracker, answer string, words []entity.Word) (game.Answer, error) {
+	return game.Answer{Answer: answer, Score: grade.Grade(answer, tracker.Right), Right: tracker.Right}, nil
+}
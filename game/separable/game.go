@@ -0,0 +1,152 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	game "github.com/peteraba/d5/game/lib"
+	"github.com/peteraba/d5/game/separable/lib"
+	"github.com/peteraba/d5/lib/german/entity"
+	"github.com/peteraba/d5/lib/play"
+	"github.com/peteraba/d5/lib/util"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+const name = "Separable"
+const version = "0.1"
+const defaultPort = "10520"
+
+var (
+	personalPronouns = []entity.PersonalPronoun{entity.S1, entity.S2, entity.S3, entity.P1, entity.P2, entity.P3}
+	tenses           = []entity.Tense{entity.Present, entity.Preterite}
+)
+
+type Separable struct{}
+
+/**
+ * MAIN
+ */
+
+func main() {
+	gameServer := Separable{}
+	game.Main(name, version, defaultPort, gameServer)
+}
+
+// GetMeta describes the game for admin
+func (s Separable) GetMeta() play.Meta {
+	return play.Meta{
+		Name:          name,
+		Description:   "Separable verb word order game: the words of a sentence put in order",
+		Categories:    []string{entity.CategoryVerb},
+		RequiresWords: true,
+		Version:       version,
+	}
+}
+
+/**
+ * DOMAIN
+ */
+
+func (s Separable) MakeGameHandle(finderUrl string, mgoCollection *mgo.Collection, debug bool) func(c *gin.Context) {
+	return func(c *gin.Context) {
+		var (
+			verb       *entity.Verb
+			query      = bson.M{}
+			gameAnswer = game.GameAnswer{}
+		)
+
+		lang := game.GetLanguage(c)
+
+		query["word.category"] = entity.CategoryVerb
+		query["word.user"] = c.Param("user")
+		query["prefix.separable"] = true
+
+		dictionary, returnCode, err := game.FetchRemediatedDictionary(c, finderUrl, mgoCollection, name, query, 1)
+		if err != nil {
+			c.JSON(returnCode, fmt.Sprint(err))
+
+			return
+		}
+
+		if len(dictionary.Verbs) > 0 && dictionary.Verbs[0].Prefix.Prefix != "" {
+			verb = &dictionary.Verbs[0]
+		}
+
+		if verb == nil {
+			gameAnswer.Error = "No separable verbs found"
+			gameAnswer.SetDebugQuery(debug, &query, &dictionary, nil, nil)
+
+			c.JSON(200, gameAnswer)
+
+			return
+		}
+
+		pp := personalPronouns[rand.Intn(len(personalPronouns))]
+		tense := tenses[rand.Intn(len(tenses))]
+		clause := lib.Clauses[rand.Intn(len(lib.Clauses))]
+
+		sentences := lib.NewSentences(verb, clause, pp, tense)
+		if len(sentences) == 0 {
+			gameAnswer.Error = "No right answer found"
+			gameAnswer.SetDebugQuery(debug, &query, nil, verb, &bson.M{"pp": pp, "tense": tense, "clause": clause})
+
+			c.JSON(200, gameAnswer)
+
+			return
+		}
+
+		// The first item marks the clause type, the sentences follow
+		right := []string{clause}
+		for _, sentence := range sentences {
+			right = append(right, sentence.String())
+		}
+
+		gameAnswer.Question = s.getQuestion(sentences[0], verb.Prefix.Prefix)
+		gameAnswer.Id = util.GenerateUid()
+
+		gameAnswer.SetDebugQuery(debug, &query, nil, verb, &bson.M{"pp": pp, "tense": tense, "clause": clause})
+		gameAnswer.SetDebugResult(debug, right[1:], lang, verb.GetMeanings(lang))
+
+		err = game.SaveAnswer(gameAnswer.GetId(), fmt.Sprintf("%s %s", clause, tense), []string{verb.GetId().Hex()}, right, mgoCollection)
+		if err != nil {
+			gameAnswer.Error = fmt.Sprint(err)
+		}
+
+		c.JSON(200, gameAnswer)
+	}
+}
+
+func (s Separable) getQuestion(sentence lib.Sentence, prefix string) string {
+	pieces := strings.Join(lib.Shuffle(sentence.GetPieces(prefix)), " / ")
+
+	switch sentence.Clause {
+	case lib.ClauseSubordinate:
+		return fmt.Sprintf("Put the words in order to form a subordinate clause starting with '%s': %s", sentence.Words[0], pieces)
+	case lib.ClauseQuestion:
+		return fmt.Sprintf("Put the words in order to form a yes/no question: %s", pieces)
+	}
+
+	return fmt.Sprintf("Put the words in order to form a main clause: %s", pieces)
+}
+
+func (s Separable) MakeCheckAnswerHandle(finderUrl, scorerUrl string, mgoCollection *mgo.Collection, debug bool) func(c *gin.Context) {
+	return game.MakeCheckAnswerHandle(name, finderUrl, scorerUrl, mgoCollection, s.gradeAnswer)
+}
+
+// gradeAnswer grades the sentence typed, the word order of the clause asked is explained
+func (s Separable) gradeAnswer(tracker game.Tracker, answer string, words []entity.Word) (game.Answer, error) {
+	if len(tracker.Right) < 2 {
+		return game.Answer{}, errors.New("Invalid answer stored.")
+	}
+
+	return game.Answer{
+		Answer:      answer,
+		Score:       lib.GradeSentence(answer, tracker.Right[0], tracker.Right[1:]),
+		Right:       tracker.Right[1:],
+		Explanation: lib.GetExplanation(tracker.Right[0]),
+	}, nil
+}
@@ -0,0 +1,283 @@
+package lib
+
+import (
+	"math/rand"
+	"strings"
+
+	"github.com/peteraba/d5/lib/german/entity"
+	"github.com/peteraba/d5/lib/german/grade"
+)
+
+const (
+	ClauseMain        = "main"
+	ClauseSubordinate = "subordinate"
+	ClauseQuestion    = "question"
+)
+
+// Partial scores for sentences with words out of order
+const (
+	ScoreOtherWords   = grade.ScoreTypo
+	ScoreVerbOrPrefix = grade.ScoreTwoTypos
+)
+
+var Clauses = []string{ClauseMain, ClauseSubordinate, ClauseQuestion}
+
+var pronouns = map[entity.PersonalPronoun][]string{
+	entity.S1: []string{"ich"},
+	entity.S2: []string{"du"},
+	entity.S3: []string{"er", "sie", "es"},
+	entity.P1: []string{"wir"},
+	entity.P2: []string{"ihr"},
+	entity.P3: []string{"sie"},
+}
+
+var adverbs = map[entity.Tense][]string{
+	entity.Present:   []string{"heute", "morgen", "jetzt", "bald"},
+	entity.Preterite: []string{"gestern", "damals", "wieder"},
+}
+
+var conjunctions = []string{"weil", "dass", "wenn", "ob"}
+
+var explanations = map[string]string{
+	ClauseMain:        "In a main clause the conjugated verb takes the second position and the separable prefix goes to the end of the clause.",
+	ClauseSubordinate: "In a subordinate clause the conjugated verb goes to the end of the clause and the separable prefix stays attached to it.",
+	ClauseQuestion:    "In a yes/no question the conjugated verb comes first and the separable prefix goes to the end of the clause.",
+}
+
+// Sentence is a clause built of a pronoun, an adverb and a separable verb
+type Sentence struct {
+	Clause string
+	Words  []string
+}
+
+// String returns the sentence written out with capitalization and punctuation
+func (s Sentence) String() string {
+	text := strings.Join(s.Words, " ")
+	text = strings.ToUpper(text[:1]) + text[1:]
+
+	if s.Clause == ClauseQuestion {
+		return text + "?"
+	}
+
+	return text + "."
+}
+
+// GetPieces returns the words to be ordered, the separable prefix and the verb are always given as separate words
+func (s Sentence) GetPieces(prefix string) []string {
+	pieces := []string{}
+
+	for _, word := range s.Words {
+		if s.Clause == ClauseSubordinate && prefix != "" && strings.HasPrefix(word, prefix) && word != prefix {
+			pieces = append(pieces, prefix, strings.TrimPrefix(word, prefix))
+
+			continue
+		}
+
+		pieces = append(pieces, word)
+	}
+
+	return pieces
+}
+
+// NewSentences builds the sentences of a clause type for every form of the verb, main clauses are built both starting
+// with the pronoun and with the adverb
+func NewSentences(verb *entity.Verb, clause string, pp entity.PersonalPronoun, tense entity.Tense) []Sentence {
+	var (
+		result      = []Sentence{}
+		pronoun     = pickOne(pronouns[pp])
+		adverb      = pickOne(adverbs[tense])
+		conjunction = pickOne(conjunctions)
+		orders      [][]string
+	)
+
+	for _, separated := range verb.GetSeparated(pp, tense) {
+		if separated[0] == "" || separated[0] == "-" {
+			continue
+		}
+
+		switch clause {
+		case ClauseMain:
+			// the adverb can also take the first position, the pronoun follows the verb then
+			orders = [][]string{
+				{pronoun, separated[0], adverb, separated[1]},
+				{adverb, separated[0], pronoun, separated[1]},
+			}
+			break
+		case ClauseQuestion:
+			orders = [][]string{{separated[0], pronoun, adverb, separated[1]}}
+			break
+		default:
+			orders = [][]string{{conjunction, pronoun, adverb, separated[1] + separated[0]}}
+		}
+
+		for _, words := range orders {
+			result = append(result, Sentence{clause, trimWords(words)})
+		}
+	}
+
+	return result
+}
+
+// GetExplanation returns the word order rule of a clause type
+func GetExplanation(clause string) string {
+	return explanations[clause]
+}
+
+// Shuffle returns the words in random order
+func Shuffle(words []string) []string {
+	result := make([]string, len(words))
+
+	for i, j := range rand.Perm(len(words)) {
+		result[i] = words[j]
+	}
+
+	return result
+}
+
+// GradeSentence compares the answer word by word with each right sentence, partial credit is only given for the right
+// words with a single pair swapped, subordinate clauses must still start with the conjunction, or for a subordinate
+// clause written with the prefix apart from the verb
+func GradeSentence(answer, clause string, right []string) int {
+	var (
+		best          = grade.ScoreWrong
+		answerWords   = NormalizeWords(answer)
+		verbRight     bool
+		prefixRight   bool
+		expectedWords []string
+	)
+
+	for _, sentence := range right {
+		expectedWords = NormalizeWords(sentence)
+
+		if strings.Join(answerWords, " ") == strings.Join(expectedWords, " ") {
+			return grade.ScoreRight
+		}
+
+		if len(answerWords) == 0 || len(expectedWords) == 0 {
+			continue
+		}
+
+		if isPrefixDetached(answerWords, expectedWords, clause) {
+			best = maxInt(best, ScoreVerbOrPrefix)
+
+			continue
+		}
+
+		if !isSameWords(answerWords, expectedWords) || countMisplaced(answerWords, expectedWords) != 2 {
+			continue
+		}
+
+		if clause == ClauseSubordinate && answerWords[0] != expectedWords[0] {
+			continue
+		}
+
+		verbRight = getVerb(answerWords, clause) == getVerb(expectedWords, clause)
+		prefixRight = answerWords[len(answerWords)-1] == expectedWords[len(expectedWords)-1]
+
+		if verbRight && prefixRight {
+			best = maxInt(best, ScoreOtherWords)
+		} else if verbRight || prefixRight {
+			best = maxInt(best, ScoreVerbOrPrefix)
+		}
+	}
+
+	return best
+}
+
+// NormalizeWords splits a sentence into lower case words without punctuation
+func NormalizeWords(sentence string) []string {
+	sentence = strings.ToLower(sentence)
+	sentence = strings.NewReplacer(".", " ", ",", " ", "?", " ", "!", " ", "/", " ").Replace(sentence)
+
+	return strings.Fields(sentence)
+}
+
+// getVerb returns the word in the position of the conjugated verb
+func getVerb(words []string, clause string) string {
+	switch clause {
+	case ClauseMain:
+		if len(words) > 1 {
+			return words[1]
+		}
+		break
+	case ClauseQuestion:
+		return words[0]
+	}
+
+	return words[len(words)-1]
+}
+
+// isPrefixDetached checks if a subordinate clause is in order but its verb is written apart from its prefix
+func isPrefixDetached(answerWords, expectedWords []string, clause string) bool {
+	if clause != ClauseSubordinate || len(answerWords) < 2 || len(answerWords) != len(expectedWords)+1 {
+		return false
+	}
+
+	last := len(answerWords) - 1
+	if strings.Join(answerWords[:last-1], " ") != strings.Join(expectedWords[:last-1], " ") {
+		return false
+	}
+
+	verb := answerWords[last-1]
+	prefix := answerWords[last]
+
+	return prefix+verb == expectedWords[last-1] || verb+prefix == expectedWords[last-1]
+}
+
+// isSameWords checks if the answer uses exactly the words of the right sentence in any order
+func isSameWords(answerWords, expectedWords []string) bool {
+	if len(answerWords) != len(expectedWords) {
+		return false
+	}
+
+	counts := map[string]int{}
+	for i := range answerWords {
+		counts[answerWords[i]]++
+		counts[expectedWords[i]]--
+	}
+
+	for _, count := range counts {
+		if count != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// countMisplaced returns the number of words not in their right position
+func countMisplaced(answerWords, expectedWords []string) int {
+	count := 0
+
+	for i := range answerWords {
+		if answerWords[i] != expectedWords[i] {
+			count++
+		}
+	}
+
+	return count
+}
+
+func trimWords(words []string) []string {
+	result := []string{}
+
+	for _, word := range words {
+		if word != "" {
+			result = append(result, word)
+		}
+	}
+
+	return result
+}
+
+func pickOne(words []string) string {
+	return words[rand.Intn(len(words))]
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
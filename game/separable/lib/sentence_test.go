@@ -0,0 +1,137 @@
+package lib
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/peteraba/d5/lib/german/entity"
+	"github.com/peteraba/d5/lib/german/grade"
+)
+
+func TestGradeSentence(t *testing.T) {
+	cases := []struct {
+		answer   string
+		clause   string
+		right    []string
+		expected int
+	}{
+		{"Ich rufe heute an.", ClauseMain, []string{"Ich rufe heute an."}, grade.ScoreRight},
+		{"ich / rufe / heute / an", ClauseMain, []string{"Ich rufe heute an."}, grade.ScoreRight},
+		{"Er ruft heute an.", ClauseMain, []string{"Sie ruft heute an.", "Er ruft heute an."}, grade.ScoreRight},
+		{"Heute rufe ich an.", ClauseMain, []string{"Ich rufe heute an."}, ScoreOtherWords},
+		{"Heute ruft er an.", ClauseMain, []string{"Er ruft heute an.", "Heute ruft er an."}, grade.ScoreRight},
+		{"Heute er ruft an.", ClauseMain, []string{"Er ruft heute an.", "Heute ruft er an."}, ScoreVerbOrPrefix},
+		{"Rufe ich heute an.", ClauseMain, []string{"Ich rufe heute an."}, ScoreVerbOrPrefix},
+		{"Ich rufe an heute.", ClauseMain, []string{"Ich rufe heute an."}, ScoreVerbOrPrefix},
+		{"Ich heute an rufe.", ClauseMain, []string{"Ich rufe heute an."}, grade.ScoreWrong},
+		{"Ich rufe heute.", ClauseMain, []string{"Ich rufe heute an."}, grade.ScoreWrong},
+		{"Ich rufe morgen an.", ClauseMain, []string{"Ich rufe heute an."}, grade.ScoreWrong},
+		{"Ich heute rufe an?", ClauseQuestion, []string{"Rufe ich heute an?"}, grade.ScoreWrong},
+		{"Rufe heute ich an?", ClauseQuestion, []string{"Rufe ich heute an?"}, ScoreOtherWords},
+		{"Ich rufe heute an?", ClauseQuestion, []string{"Rufe ich heute an?"}, ScoreVerbOrPrefix},
+		{"Weil ich heute anrufe.", ClauseSubordinate, []string{"Weil ich heute anrufe."}, grade.ScoreRight},
+		{"Weil heute ich anrufe.", ClauseSubordinate, []string{"Weil ich heute anrufe."}, ScoreOtherWords},
+		{"Heute ich weil anrufe.", ClauseSubordinate, []string{"Weil ich heute anrufe."}, grade.ScoreWrong},
+		{"Weil ich heute an rufe.", ClauseSubordinate, []string{"Weil ich heute anrufe."}, ScoreVerbOrPrefix},
+		{"Weil ich heute rufe an.", ClauseSubordinate, []string{"Weil ich heute anrufe."}, ScoreVerbOrPrefix},
+		{"Heute weil ich rufe an.", ClauseSubordinate, []string{"Weil ich heute anrufe."}, grade.ScoreWrong},
+		{"", ClauseSubordinate, []string{"Weil ich heute anrufe."}, grade.ScoreWrong},
+	}
+
+	for num, testCase := range cases {
+		actual := GradeSentence(testCase.answer, testCase.clause, testCase.right)
+
+		if actual != testCase.expected {
+			t.Fatalf("Case #%d: Expected score %d for '%s', got: %d", num+1, testCase.expected, testCase.answer, actual)
+		}
+	}
+
+	t.Log(len(cases), "test cases")
+}
+
+func TestNewSentences(t *testing.T) {
+	verb := entity.NewVerb("h", "an|rufen", map[string]string{entity.LangEnglish: "to call"}, "", "", "", "")
+
+	cases := []struct {
+		clause   string
+		sentence int
+		first    string
+		second   string
+		third    string
+		last     string
+	}{
+		{ClauseMain, 0, "ich", "rufe", "", "an"},
+		{ClauseMain, 1, "", "rufe", "ich", "an"},
+		{ClauseQuestion, 0, "rufe", "ich", "", "an"},
+		{ClauseSubordinate, 0, "", "ich", "", "anrufe"},
+	}
+
+	for num, testCase := range cases {
+		sentences := NewSentences(verb, testCase.clause, entity.S1, entity.Present)
+
+		count := 1
+		if testCase.clause == ClauseMain {
+			count = 2
+		}
+
+		if len(sentences) != count {
+			t.Fatalf("Case #%d: Expected %d sentences, got: %d", num+1, count, len(sentences))
+		}
+
+		words := sentences[testCase.sentence].Words
+
+		if len(words) != 4 || sentences[testCase.sentence].Clause != testCase.clause {
+			t.Fatalf("Case #%d: Unexpected sentence: %v", num+1, sentences[testCase.sentence])
+		}
+
+		if testCase.first != "" && words[0] != testCase.first {
+			t.Fatalf("Case #%d: Expected '%s' first, got: %v", num+1, testCase.first, words)
+		}
+
+		if testCase.third != "" && words[2] != testCase.third {
+			t.Fatalf("Case #%d: Expected '%s' third, got: %v", num+1, testCase.third, words)
+		}
+
+		if words[1] != testCase.second || words[3] != testCase.last {
+			t.Fatalf("Case #%d: Expected '%s' second and '%s' last, got: %v", num+1, testCase.second, testCase.last, words)
+		}
+	}
+
+	main := NewSentences(verb, ClauseMain, entity.S3, entity.Present)
+	right := []string{}
+	for _, sentence := range main {
+		right = append(right, sentence.String())
+	}
+
+	answer := strings.Join(main[0].Words, " ")
+	inverted := strings.Join(main[1].Words, " ")
+	if GradeSentence(answer, ClauseMain, right) != grade.ScoreRight || GradeSentence(inverted, ClauseMain, right) != grade.ScoreRight {
+		t.Fatalf("Expected both '%s' and '%s' to be right", answer, inverted)
+	}
+
+	t.Log(len(cases)+1, "test cases")
+}
+
+func TestGetPieces(t *testing.T) {
+	cases := []struct {
+		sentence Sentence
+		prefix   string
+		expected []string
+	}{
+		{Sentence{ClauseMain, []string{"ich", "rufe", "heute", "an"}}, "an", []string{"ich", "rufe", "heute", "an"}},
+		{Sentence{ClauseQuestion, []string{"rufe", "ich", "heute", "an"}}, "an", []string{"rufe", "ich", "heute", "an"}},
+		{Sentence{ClauseSubordinate, []string{"weil", "ich", "heute", "anrufe"}}, "an", []string{"weil", "ich", "heute", "an", "rufe"}},
+		{Sentence{ClauseSubordinate, []string{"ob", "ich", "heute", "aufhöre"}}, "auf", []string{"ob", "ich", "heute", "auf", "höre"}},
+		{Sentence{ClauseSubordinate, []string{"weil", "ich", "heute", "rufe"}}, "", []string{"weil", "ich", "heute", "rufe"}},
+	}
+
+	for num, testCase := range cases {
+		actual := testCase.sentence.GetPieces(testCase.prefix)
+
+		if strings.Join(actual, " ") != strings.Join(testCase.expected, " ") {
+			t.Fatalf("Case #%d: Expected pieces %v, got: %v", num+1, testCase.expected, actual)
+		}
+	}
+
+	t.Log(len(cases), "test cases")
+}
@@ -0,0 +1,225 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	game "github.com/peteraba/d5/game/lib"
+	"github.com/peteraba/d5/game/translate/lib"
+	"github.com/peteraba/d5/lib/german"
+	"github.com/peteraba/d5/lib/german/entity"
+	"github.com/peteraba/d5/lib/play"
+	"github.com/peteraba/d5/lib/util"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+const name = "Translate"
+const version = "0.1"
+const defaultPort = "10430"
+
+const (
+	directionGerman  = "german"
+	directionMeaning = "meaning"
+	optionCount      = 4
+	candidateLimit   = 30
+	maxMeaningCount  = 2
+)
+
+type Translate struct{}
+
+/**
+ * MAIN
+ */
+
+func main() {
+	gameServer := Translate{}
+	game.Main(name, version, defaultPort, gameServer)
+}
+
+// GetMeta describes the game for admin
+func (t Translate) GetMeta() play.Meta {
+	return play.Meta{
+		Name:          name,
+		Description:   "Multiple choice translation game: a word or one of its meanings chosen from four options",
+		RequiresWords: true,
+		Options: []play.Option{
+			{Name: "direction", Description: "language of the question, random by default", Values: []string{directionGerman, directionMeaning}},
+		},
+		Version: version,
+	}
+}
+
+/**
+ * DOMAIN
+ */
+
+func (t Translate) MakeGameHandle(finderUrl string, mgoCollection *mgo.Collection, debug bool) func(c *gin.Context) {
+	return func(c *gin.Context) {
+		var (
+			words       []entity.Word
+			word        entity.Word
+			distractors []entity.Word
+			query       = bson.M{}
+			err         error
+			returnCode  int
+		)
+
+		lang := game.GetLanguage(c)
+		direction := t.getDirection(c)
+		getText := t.makeGetText(direction, lang)
+
+		query["word.user"] = c.Param("user")
+
+		words, returnCode, err = game.FetchRemediatedWords(c, finderUrl, mgoCollection, name, query, 1)
+		if err != nil {
+			c.JSON(returnCode, fmt.Sprint(err))
+
+			return
+		}
+
+		word = words[0]
+
+		distractors = t.fetchDistractors(finderUrl, word, c.Param("user"), getText)
+
+		gameOption, right := t.getGameOption(word, distractors, direction, lang, getText)
+
+		gameOption.SetDebugQuery(debug, &query, nil, word, &bson.M{"direction": direction, "distractors": distractors})
+		gameOption.SetDebugResult(debug, right, lang, word.GetMeanings(lang))
+
+		err = game.SaveAnswer(gameOption.GetId(), direction, []string{word.GetId().Hex()}, right, mgoCollection)
+		if err != nil {
+			gameOption.Error = fmt.Sprint(err)
+		}
+
+		c.JSON(200, gameOption)
+	}
+}
+
+// getDirection returns the direction sent as parameter, a random one is chosen by default
+func (t Translate) getDirection(c *gin.Context) string {
+	direction := c.Query("direction")
+	if direction == directionGerman || direction == directionMeaning {
+		return direction
+	}
+
+	if rand.Int31n(2) == 0 {
+		return directionGerman
+	}
+
+	return directionMeaning
+}
+
+// makeGetText returns the function creating the option text of a word
+func (t Translate) makeGetText(direction, lang string) func(entity.Word) string {
+	if direction == directionMeaning {
+		return func(word entity.Word) string {
+			return word.GetGerman()
+		}
+	}
+
+	return func(word entity.Word) string {
+		return getMeaningText(word, lang)
+	}
+}
+
+func getMeaningText(word entity.Word, lang string) string {
+	mains := []string{}
+
+	for _, meaning := range word.GetMeanings(lang) {
+		if len(mains) >= maxMeaningCount {
+			break
+		}
+
+		mains = append(mains, meaning.GetMain())
+	}
+
+	return strings.Join(mains, "; ")
+}
+
+// fetchDistractors looks for distractors in the category of the word first, then in the whole dictionary of the user
+func (t Translate) fetchDistractors(finderUrl string, word entity.Word, user string, getText func(entity.Word) string) []entity.Word {
+	var (
+		candidates  []entity.Word
+		distractors []entity.Word
+		queries     = []bson.M{
+			bson.M{"word.user": user, "word.category": german.CategoryQuery(word.GetCategory())},
+			bson.M{"word.user": user},
+		}
+	)
+
+	for _, query := range queries {
+		found, _, err := game.FetchWords(finderUrl, query, candidateLimit)
+		if err != nil {
+			continue
+		}
+
+		candidates = append(candidates, found...)
+
+		distractors = lib.PickDistractors(word, candidates, optionCount-1, getText)
+		if len(distractors) == optionCount-1 {
+			break
+		}
+	}
+
+	return distractors
+}
+
+// getGameOption puts the right answer to a random position, the tracker stores its position and text
+func (t Translate) getGameOption(word entity.Word, distractors []entity.Word, direction, lang string, getText func(entity.Word) string) (game.GameOption, []string) {
+	var (
+		gameOption = game.GameOption{}
+		options    = []string{}
+		rightIdx   = rand.Intn(len(distractors) + 1)
+	)
+
+	for _, distractor := range distractors {
+		options = append(options, getText(distractor))
+	}
+
+	options = append(options[:rightIdx], append([]string{getText(word)}, options[rightIdx:]...)...)
+
+	if direction == directionMeaning {
+		gameOption.Question = fmt.Sprintf("What's the German word for '%s'?", getMeaningText(word, lang))
+	} else {
+		gameOption.Question = fmt.Sprintf("What does '%s' mean?", word.GetGerman())
+	}
+
+	for len(options) < optionCount {
+		options = append(options, "")
+	}
+
+	gameOption.Option1 = options[0]
+	gameOption.Option2 = options[1]
+	gameOption.Option3 = options[2]
+	gameOption.Option4 = options[3]
+	gameOption.Id = util.GenerateUid()
+
+	return gameOption, []string{strconv.Itoa(rightIdx + 1), getText(word)}
+}
+
+func (t Translate) MakeCheckAnswerHandle(finderUrl, scorerUrl string, mgoCollection *mgo.Collection, debug bool) func(c *gin.Context) {
+	return game.MakeCheckAnswerHandle(name, finderUrl, scorerUrl, mgoCollection, t.gradeAnswer)
+}
+
+// gradeAnswer grades the translation typed
+func (t Translate) gradeAnswer(tracker game.Tracker, answer string, words []entity.Word) (game.Answer, error) {
+	if len(tracker.Right) < 1 {
+		return game.Answer{}, errors.New("Invalid answer stored.")
+	}
+
+	return game.Answer{Answer: answer, Score: t.CheckAnswer(tracker, answer), Right: tracker.Right[1:]}, nil
+}
+
+// CheckAnswer compares the number of the option chosen with the position of the right answer
+func (t Translate) CheckAnswer(tracker game.Tracker, answer string) int {
+	if len(tracker.Right) > 0 && tracker.Right[0] == answer {
+		return 10
+	}
+
+	return 0
+}
@@ -0,0 +1,43 @@
+package lib
+
+import (
+	"math"
+	"sort"
+
+	"github.com/peteraba/d5/lib/general"
+	"github.com/peteraba/d5/lib/german/entity"
+)
+
+// PickDistractors chooses words with a text different from the target's, preferring words of a difficulty close to the target's
+func PickDistractors(target entity.Word, candidates []entity.Word, count int, getText func(entity.Word) string) []entity.Word {
+	var (
+		distractors = []entity.Word{}
+		sorted      = append([]entity.Word{}, candidates...)
+		texts       = map[string]bool{getText(target): true}
+		difficulty  = general.GetDifficulty(target.GetScores())
+	)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return getDistance(sorted[i], difficulty) < getDistance(sorted[j], difficulty)
+	})
+
+	for _, candidate := range sorted {
+		if len(distractors) >= count {
+			break
+		}
+
+		text := getText(candidate)
+		if text == "" || texts[text] || candidate.GetId() == target.GetId() {
+			continue
+		}
+
+		texts[text] = true
+		distractors = append(distractors, candidate)
+	}
+
+	return distractors
+}
+
+func getDistance(word entity.Word, difficulty float64) float64 {
+	return math.Abs(general.GetDifficulty(word.GetScores()) - difficulty)
+}
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	game "github.com/peteraba/d5/game/lib"
+	"github.com/peteraba/d5/lib/german/entity"
+	"github.com/peteraba/d5/lib/german/grade"
+	"github.com/peteraba/d5/lib/play"
+	"github.com/peteraba/d5/lib/util"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+const name = "Valency"
+const version = "0.1"
+const defaultPort = "10480"
+
+const (
+	modePreposition = "preposition"
+	modeCase        = "case"
+	modeReflexive   = "reflexive"
+)
+
+// caseAnswers lists the accepted names of each case
+var caseAnswers = map[entity.Case][]string{
+	entity.CaseNominative: []string{"nominative", "nominativ", "N"},
+	entity.CaseAcusative:  []string{"accusative", "akkusativ", "A"},
+	entity.CaseDative:     []string{"dative", "dativ", "D"},
+	entity.CaseGenitive:   []string{"genitive", "genitiv", "G"},
+}
+
+type Valency struct{}
+
+/**
+ * MAIN
+ */
+
+func main() {
+	gameServer := Valency{}
+	game.Main(name, version, defaultPort, gameServer)
+}
+
+// GetMeta describes the game for admin
+func (v Valency) GetMeta() play.Meta {
+	return play.Meta{
+		Name:          name,
+		Description:   "Verb valency game: the preposition a verb takes, the case following it or the case of its reflexive pronoun",
+		Categories:    []string{entity.CategoryVerb},
+		RequiresWords: true,
+		Version:       version,
+	}
+}
+
+/**
+ * DOMAIN
+ */
+
+func (v Valency) MakeGameHandle(finderUrl string, mgoCollection *mgo.Collection, debug bool) func(c *gin.Context) {
+	return func(c *gin.Context) {
+		var (
+			verb       *entity.Verb
+			query      = bson.M{}
+			gameAnswer = game.GameAnswer{}
+		)
+
+		lang := game.GetLanguage(c)
+
+		query["word.category"] = entity.CategoryVerb
+		query["word.user"] = c.Param("user")
+		query["$or"] = []bson.M{
+			bson.M{"arguments.0": bson.M{"$exists": true}},
+			bson.M{"reflexive": bson.M{"$in": []string{entity.ReflexiveAcusative, entity.ReflexiveDative}}},
+		}
+
+		dictionary, returnCode, err := game.FetchRemediatedDictionary(c, finderUrl, mgoCollection, name, query, 1)
+		if err != nil {
+			c.JSON(returnCode, fmt.Sprint(err))
+
+			return
+		}
+
+		if len(dictionary.Verbs) > 0 {
+			verb = &dictionary.Verbs[0]
+		}
+
+		if verb == nil {
+			gameAnswer.Error = "No verbs with arguments found"
+			gameAnswer.SetDebugQuery(debug, &query, &dictionary, nil, nil)
+
+			c.JSON(200, gameAnswer)
+
+			return
+		}
+
+		question, right, mode := v.getQuestion(verb)
+
+		gameAnswer.Question = question
+		gameAnswer.Id = util.GenerateUid()
+
+		gameAnswer.SetDebugQuery(debug, &query, nil, verb, &bson.M{"mode": mode})
+		gameAnswer.SetDebugResult(debug, right, lang, verb.GetMeanings(lang))
+
+		if len(right) == 0 {
+			gameAnswer.Error = "No right answer found"
+
+			c.JSON(200, gameAnswer)
+
+			return
+		}
+
+		err = game.SaveAnswer(gameAnswer.GetId(), mode, []string{verb.GetId().Hex()}, right, mgoCollection)
+		if err != nil {
+			gameAnswer.Error = fmt.Sprint(err)
+		}
+
+		c.JSON(200, gameAnswer)
+	}
+}
+
+// getQuestion asks about a random argument or the reflexive pronoun of the verb
+func (v Valency) getQuestion(verb *entity.Verb) (string, []string, string) {
+	var (
+		modes    = []string{}
+		argument entity.Argument
+	)
+
+	if len(verb.Arguments) > 0 {
+		argument = verb.Arguments[rand.Intn(len(verb.Arguments))]
+
+		if argument.Preposition != "" {
+			modes = append(modes, modePreposition)
+		}
+
+		if argument.Case != "" {
+			modes = append(modes, modeCase)
+		}
+	}
+
+	if verb.Reflexive == entity.ReflexiveAcusative || verb.Reflexive == entity.ReflexiveDative {
+		modes = append(modes, modeReflexive)
+	}
+
+	if len(modes) == 0 {
+		return "", []string{}, ""
+	}
+
+	infinitive := v.getInfinitive(verb)
+
+	switch mode := modes[rand.Intn(len(modes))]; mode {
+	case modePreposition:
+		return fmt.Sprintf("Which preposition does '%s' take?", infinitive), v.getPrepositions(verb), mode
+	case modeCase:
+		if argument.Preposition == "" {
+			return fmt.Sprintf("Which case does '%s' take?", infinitive), caseAnswers[argument.Case], mode
+		}
+
+		return fmt.Sprintf("Which case follows '%s %s'?", infinitive, argument.Preposition), caseAnswers[argument.Case], mode
+	}
+
+	return fmt.Sprintf("Is the reflexive pronoun of 'sich %s' accusative or dative?", infinitive), caseAnswers[entity.Case(verb.Reflexive)], modeReflexive
+}
+
+// getPrepositions returns all prepositions of the verb, any of them is accepted
+func (v Valency) getPrepositions(verb *entity.Verb) []string {
+	prepositions := []string{}
+
+	for _, argument := range verb.Arguments {
+		if argument.Preposition != "" && !util.StringIn(argument.Preposition, prepositions) {
+			prepositions = append(prepositions, argument.Preposition)
+		}
+	}
+
+	return prepositions
+}
+
+func (v Valency) getInfinitive(verb *entity.Verb) string {
+	parts := []string{}
+
+	for _, part := range []string{verb.Noun, verb.Adjective, verb.GetGerman()} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func (v Valency) MakeCheckAnswerHandle(finderUrl, scorerUrl string, mgoCollection *mgo.Collection, debug bool) func(c *gin.Context) {
+	return game.MakeCheckAnswerHandle(name, finderUrl, scorerUrl, mgoCollection, v.gradeAnswer)
+}
+
+// gradeAnswer grades the case typed
+func (v Valency) gradeAnswer(tracker game.Tracker, answer string, words []entity.Word) (game.Answer, error) {
+	return game.Answer{Answer: answer, Score: grade.GradeStrict(answer, tracker.Right), Right: tracker.Right}, nil
+}
@@ -0,0 +1,85 @@
+package backtest
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// RecallThreshold is the lowest result counted as a successful recall
+	RecallThreshold = 5
+	// DefaultRetention is the probability of recall at which a word becomes due
+	DefaultRetention = 0.9
+
+	// minProbability keeps the log-loss finite for predictions of 0 or 1
+	minProbability = 1e-6
+)
+
+// Report holds the metrics of a strategy replayed over the score histories, reviews are the ones of new and due words,
+// early reviews are the ones the strategy would have skipped and overdue words are due at the end of the replay
+type Report struct {
+	Strategy    string  `json:"strategy"`
+	Words       int     `json:"words"`
+	Predictions int     `json:"predictions"`
+	Accuracy    float64 `json:"accuracy"`
+	LogLoss     float64 `json:"logLoss"`
+	Reviews     int     `json:"reviews"`
+	Early       int     `json:"early"`
+	Overdue     int     `json:"overdue"`
+}
+
+// Run replays the histories against a strategy, every score but the first of a word is predicted from the scores before it
+func Run(name string, strategy Strategy, histories []History, retention float64, end time.Time) Report {
+	var (
+		report  = Report{Strategy: name, Words: len(histories)}
+		right   int
+		logLoss float64
+	)
+
+	for _, history := range histories {
+		state := State{}
+
+		for num, score := range history {
+			if num > 0 {
+				probability := strategy.Recall(state, score.LearnedAt)
+				recalled := score.Result >= RecallThreshold
+
+				if (probability >= 0.5) == recalled {
+					right++
+				}
+
+				logLoss += getLogLoss(probability, recalled)
+				report.Predictions++
+			}
+
+			if num == 0 || strategy.Recall(state, score.LearnedAt) <= retention {
+				report.Reviews++
+			} else {
+				report.Early++
+			}
+
+			state = strategy.Review(state, score)
+		}
+
+		if len(history) > 0 && strategy.Recall(state, end) <= retention {
+			report.Overdue++
+		}
+	}
+
+	if report.Predictions > 0 {
+		report.Accuracy = float64(right) / float64(report.Predictions)
+		report.LogLoss = logLoss / float64(report.Predictions)
+	}
+
+	return report
+}
+
+func getLogLoss(probability float64, recalled bool) float64 {
+	probability = math.Max(minProbability, math.Min(1-minProbability, probability))
+
+	if recalled {
+		return -math.Log(probability)
+	}
+
+	return -math.Log(1 - probability)
+}
@@ -0,0 +1,131 @@
+package backtest
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/peteraba/d5/lib/general"
+)
+
+var replayStart = time.Date(2016, 10, 1, 12, 0, 0, 0, time.UTC)
+
+func makeHistory(results []int, days []float64) History {
+	history := History{}
+
+	for num, result := range results {
+		learnedAt := replayStart.Add(time.Duration(days[num] * 24 * float64(time.Hour)))
+
+		history = append(history, &general.Score{Result: result, LearnedAt: learnedAt})
+	}
+
+	return history
+}
+
+var runCases = []struct {
+	strategy            string
+	histories           []History
+	end                 time.Time
+	expectedPredictions int
+	expectedAccuracy    float64
+	expectedReviews     int
+	expectedEarly       int
+	expectedOverdue     int
+}{
+	{
+		StrategySM2,
+		[]History{makeHistory([]int{10, 10, 10}, []float64{0, 1, 7})},
+		replayStart.Add(8 * 24 * time.Hour),
+		2,
+		1,
+		3,
+		0,
+		0,
+	},
+	{
+		StrategySM2,
+		[]History{makeHistory([]int{10, 10}, []float64{0, 0.1})},
+		replayStart.Add(30 * 24 * time.Hour),
+		1,
+		1,
+		1,
+		1,
+		1,
+	},
+	{
+		StrategyFSRS,
+		[]History{makeHistory([]int{10, 0}, []float64{0, 60}), makeHistory([]int{0}, []float64{0})},
+		replayStart.Add(60 * 24 * time.Hour),
+		1,
+		1,
+		3,
+		0,
+		1,
+	},
+	{
+		StrategyProgress,
+		[]History{makeHistory([]int{10, 10}, []float64{0, 0.01})},
+		replayStart.Add(24 * time.Hour),
+		1,
+		1,
+		1,
+		1,
+		0,
+	},
+}
+
+func TestRun(t *testing.T) {
+	for num, testCase := range runCases {
+		strategy, err := GetStrategy(testCase.strategy, DefaultScale)
+		if err != nil {
+			t.Fatalf("Case #%d: Unexpected error: %v", num+1, err)
+		}
+
+		report := Run(testCase.strategy, strategy, testCase.histories, DefaultRetention, testCase.end)
+
+		if report.Words != len(testCase.histories) || report.Predictions != testCase.expectedPredictions {
+			t.Fatalf("Case #%d: Expected %d words and %d predictions, got: %d and %d", num+1, len(testCase.histories), testCase.expectedPredictions, report.Words, report.Predictions)
+		}
+
+		if report.Accuracy != testCase.expectedAccuracy {
+			t.Fatalf("Case #%d: Expected accuracy: %v, got: %v", num+1, testCase.expectedAccuracy, report.Accuracy)
+		}
+
+		if report.Reviews != testCase.expectedReviews || report.Early != testCase.expectedEarly || report.Overdue != testCase.expectedOverdue {
+			t.Fatalf("Case #%d: Expected %d reviews, %d early and %d overdue, got: %d, %d and %d", num+1, testCase.expectedReviews, testCase.expectedEarly, testCase.expectedOverdue, report.Reviews, report.Early, report.Overdue)
+		}
+	}
+
+	t.Log(len(runCases), "test cases")
+}
+
+func TestRunLogLoss(t *testing.T) {
+	strategy, _ := GetStrategy(StrategySM2, DefaultScale)
+	histories := []History{makeHistory([]int{10, 0}, []float64{0, 1})}
+
+	report := Run(StrategySM2, strategy, histories, DefaultRetention, replayStart)
+
+	// the word was forgotten at the due date when the probability of recall is 0.9
+	expected := -math.Log(1 - DefaultRetention)
+	if math.Abs(report.LogLoss-expected) > 1e-9 || report.Accuracy != 0 {
+		t.Fatalf("Expected log-loss: %v and accuracy: 0, got: %v and %v", expected, report.LogLoss, report.Accuracy)
+	}
+
+	t.Log(1, "test case")
+}
+
+func TestGetStrategyFailsOnUnknownStrategy(t *testing.T) {
+	for _, name := range StrategyNames {
+		_, err := GetStrategy(name, DefaultScale)
+		if err != nil {
+			t.Fatalf("Unexpected error for strategy %s: %v", name, err)
+		}
+	}
+
+	_, err := GetStrategy("random", DefaultScale)
+	if err == nil {
+		t.Fatalf("Expected an error for an unknown strategy")
+	}
+
+	t.Log(len(StrategyNames)+1, "test cases")
+}
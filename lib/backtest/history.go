@@ -0,0 +1,148 @@
+package backtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/peteraba/d5/lib/general"
+)
+
+// History is the list of scores of a word in the order they were given
+type History []*general.Score
+
+// document is an exported word, scores are stored in the embedded word as in the finder's and mongoexport's output
+type document struct {
+	Word struct {
+		Scores []scoreDocument `json:"scores"`
+	} `json:"word"`
+}
+
+type scoreDocument struct {
+	Result    int          `json:"result"`
+	LearnedAt documentTime `json:"learned_at"`
+}
+
+// documentTime accepts both plain RFC 3339 dates and the extended JSON dates of mongoexport
+type documentTime struct {
+	time.Time
+}
+
+func (t *documentTime) UnmarshalJSON(data []byte) error {
+	var (
+		raw      interface{}
+		extended struct {
+			Date interface{} `json:"$date"`
+		}
+	)
+
+	if data[0] == '{' {
+		err := json.Unmarshal(data, &extended)
+		if err != nil {
+			return err
+		}
+
+		raw = extended.Date
+	} else {
+		err := json.Unmarshal(data, &raw)
+		if err != nil {
+			return err
+		}
+	}
+
+	switch value := raw.(type) {
+	case string:
+		parsed, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return err
+		}
+
+		t.Time = parsed
+
+		return nil
+	case float64:
+		t.Time = time.Unix(0, int64(value)*int64(time.Millisecond))
+
+		return nil
+	case map[string]interface{}:
+		millis, err := strconv.ParseInt(fmt.Sprint(value["$numberLong"]), 10, 64)
+		if err != nil {
+			return err
+		}
+
+		t.Time = time.Unix(0, millis*int64(time.Millisecond))
+
+		return nil
+	}
+
+	return errors.New(fmt.Sprintf("Invalid date: %s", string(data)))
+}
+
+// ParseHistories reads the score histories of words exported either as a JSON array or as one JSON document per line, words never scored are skipped
+func ParseHistories(input []byte) ([]History, error) {
+	var (
+		documents []document
+		histories = []History{}
+	)
+
+	input = bytes.TrimSpace(input)
+
+	if len(input) > 0 && input[0] == '[' {
+		err := json.Unmarshal(input, &documents)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		for num, line := range bytes.Split(input, []byte("\n")) {
+			var d document
+
+			line = bytes.TrimSpace(line)
+			if len(line) == 0 {
+				continue
+			}
+
+			err := json.Unmarshal(line, &d)
+			if err != nil {
+				return nil, errors.New(fmt.Sprintf("Invalid document in line %d: %v", num+1, err))
+			}
+
+			documents = append(documents, d)
+		}
+	}
+
+	for _, d := range documents {
+		if len(d.Word.Scores) == 0 {
+			continue
+		}
+
+		history := History{}
+		for _, score := range d.Word.Scores {
+			history = append(history, &general.Score{Result: score.Result, LearnedAt: score.LearnedAt.Time})
+		}
+
+		sort.SliceStable(history, func(i, j int) bool {
+			return history[i].LearnedAt.Before(history[j].LearnedAt)
+		})
+
+		histories = append(histories, history)
+	}
+
+	return histories, nil
+}
+
+// GetLastReview returns the time of the latest score in any of the histories
+func GetLastReview(histories []History) time.Time {
+	var last time.Time
+
+	for _, history := range histories {
+		if at := history[len(history)-1].LearnedAt; at.After(last) {
+			last = at
+		}
+	}
+
+	return last
+}
@@ -0,0 +1,79 @@
+package backtest
+
+import (
+	"testing"
+	"time"
+)
+
+var parseHistoriesCases = []struct {
+	input           string
+	expectedLengths []int
+	expectedFirst   time.Time
+}{
+	{
+		`[{"_id": "5a1c5b1e288a2139ea45f52e", "article": [1], "word": {"german": "Hund", "category": "noun", "user": "peteraba", "scores": [{"result": 10, "learned_at": "2016-10-02T12:00:00Z"}, {"result": 3, "learned_at": "2016-10-01T12:00:00Z"}]}}]`,
+		[]int{2},
+		time.Date(2016, 10, 1, 12, 0, 0, 0, time.UTC),
+	},
+	{
+		"{\"_id\": {\"$oid\": \"5a1c5b1e288a2139ea45f52e\"}, \"word\": {\"german\": \"Hund\", \"scores\": [{\"result\": 10, \"learned_at\": {\"$date\": \"2016-10-01T12:00:00.000Z\"}}]}}\n\n{\"word\": {\"german\": \"Katze\"}}\n{\"word\": {\"german\": \"gehen\", \"scores\": [{\"result\": 1, \"learned_at\": {\"$date\": 1475323200000}}]}}\n",
+		[]int{1, 1},
+		time.Date(2016, 10, 1, 12, 0, 0, 0, time.UTC),
+	},
+	{
+		`{"word": {"german": "Hund", "scores": [{"result": 10, "learned_at": {"$date": {"$numberLong": "1475323200000"}}}]}}`,
+		[]int{1},
+		time.Date(2016, 10, 1, 12, 0, 0, 0, time.UTC),
+	},
+	{
+		`{"german": "Hund", "scores": [{"result": 10, "learned_at": "2016-10-01T12:00:00Z"}]}`,
+		[]int{},
+		time.Time{},
+	},
+	{
+		``,
+		[]int{},
+		time.Time{},
+	},
+}
+
+func TestParseHistories(t *testing.T) {
+	for num, testCase := range parseHistoriesCases {
+		histories, err := ParseHistories([]byte(testCase.input))
+		if err != nil {
+			t.Fatalf("Case #%d: Unexpected error: %v", num+1, err)
+		}
+
+		if len(histories) != len(testCase.expectedLengths) {
+			t.Fatalf("Case #%d: Expected %d histories, got: %d", num+1, len(testCase.expectedLengths), len(histories))
+		}
+
+		for i, history := range histories {
+			if len(history) != testCase.expectedLengths[i] {
+				t.Fatalf("Case #%d: Expected %d scores in history #%d, got: %d", num+1, testCase.expectedLengths[i], i+1, len(history))
+			}
+		}
+
+		if len(histories) > 0 && !histories[0][0].LearnedAt.Equal(testCase.expectedFirst) {
+			t.Fatalf("Case #%d: Expected first score at %v, got: %v", num+1, testCase.expectedFirst, histories[0][0].LearnedAt)
+		}
+	}
+
+	t.Log(len(parseHistoriesCases), "test cases")
+}
+
+func TestParseHistoriesFailsOnInvalidDocument(t *testing.T) {
+	inputs := []string{
+		`{"word": {"scores": [{"result": 10, "learned_at": "yesterday"}]}}`,
+		"{\"word\": {\"scores\": []}}\n{\"word\": ",
+	}
+
+	for num, input := range inputs {
+		_, err := ParseHistories([]byte(input))
+		if err == nil {
+			t.Fatalf("Case #%d: Expected an error", num+1)
+		}
+	}
+
+	t.Log(len(inputs), "test cases")
+}
@@ -0,0 +1,85 @@
+package backtest
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/peteraba/d5/lib/general"
+)
+
+const (
+	StrategyProgress = "progress"
+	StrategySM2      = general.AlgorithmSM2
+	StrategyFSRS     = general.AlgorithmFSRS
+
+	// DefaultScale divides progress scores before they are turned into a probability
+	DefaultScale = 1.0
+)
+
+// StrategyNames lists the strategies known by GetStrategy
+var StrategyNames = []string{StrategyProgress, StrategySM2, StrategyFSRS}
+
+// State is what a strategy knows about a word at a point of the replay
+type State struct {
+	Scores   []*general.Score
+	Schedule general.Schedule
+}
+
+// Strategy ranks words by predicting how likely they are to be recalled
+type Strategy interface {
+	// Recall predicts the probability of recalling a word at a given time
+	Recall(state State, now time.Time) float64
+	// Review returns the state of a word after a score
+	Review(state State, score *general.Score) State
+}
+
+// GetStrategy returns a strategy by name, scale is only used by the progress strategy
+func GetStrategy(name string, scale float64) (Strategy, error) {
+	switch name {
+	case StrategyProgress:
+		return Progress{Scale: scale}, nil
+	case StrategySM2, StrategyFSRS:
+		return Scheduled{Scheduler: general.GetScheduler(name)}, nil
+	}
+
+	return nil, errors.New(fmt.Sprintf("Unknown strategy: %s", name))
+}
+
+// Progress is the ranking used by the finder: the learned at and progress scores turned into a probability by a logistic curve
+type Progress struct {
+	Scale float64
+}
+
+func (p Progress) Recall(state State, now time.Time) float64 {
+	if len(state.Scores) == 0 {
+		return 0
+	}
+
+	score := general.GetProgressScoreAt(state.Scores, now) + general.GetLearnedAtScoreAt(state.Scores[0].LearnedAt, now)
+
+	return 1 / (1 + math.Exp(float64(score)/p.Scale))
+}
+
+func (p Progress) Review(state State, score *general.Score) State {
+	state.Scores = append(state.Scores, score)
+
+	return state
+}
+
+// Scheduled predicts recall from the schedule of a spaced repetition algorithm
+type Scheduled struct {
+	Scheduler general.Scheduler
+}
+
+func (s Scheduled) Recall(state State, now time.Time) float64 {
+	return state.Schedule.Retrievability(now)
+}
+
+func (s Scheduled) Review(state State, score *general.Score) State {
+	state.Scores = append(state.Scores, score)
+	state.Schedule = s.Scheduler.Review(state.Schedule, score.Result, score.LearnedAt)
+
+	return state
+}
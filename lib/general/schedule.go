@@ -0,0 +1,257 @@
+package general
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	AlgorithmSM2  = "sm2"
+	AlgorithmFSRS = "fsrs"
+)
+
+// Schedule holds the spaced repetition state of a word, words never reviewed have a zero due date
+type Schedule struct {
+	Algorithm  string    `bson:"algorithm" json:"algorithm,omitempty"`
+	Interval   float64   `bson:"interval" json:"interval,omitempty"`
+	Ease       float64   `bson:"ease" json:"ease,omitempty"`
+	Stability  float64   `bson:"stability" json:"stability,omitempty"`
+	Difficulty float64   `bson:"difficulty" json:"difficulty,omitempty"`
+	Reviews    int       `bson:"reviews" json:"reviews,omitempty"`
+	Streak     int       `bson:"streak" json:"streak,omitempty"`
+	Lapses     int       `bson:"lapses" json:"lapses,omitempty"`
+	LastReview time.Time `bson:"last_review" json:"last_review,omitempty"`
+	Due        time.Time `bson:"due" json:"due,omitempty"`
+}
+
+// IsNew checks if the word has never been scheduled
+func (s Schedule) IsNew() bool {
+	return s.Due.IsZero()
+}
+
+// IsDue checks if a scheduled word should be reviewed
+func (s Schedule) IsDue(now time.Time) bool {
+	return !s.IsNew() && !s.Due.After(now)
+}
+
+// Retrievability estimates the probability of recalling a scheduled word at a given time, it drops to the aimed retention at the due date
+func (s Schedule) Retrievability(now time.Time) float64 {
+	if s.IsNew() {
+		return 0
+	}
+
+	elapsed := math.Max(0, now.Sub(s.LastReview).Hours()/24)
+
+	if s.Algorithm == AlgorithmFSRS && s.Stability > 0 {
+		return math.Pow(1+fsrsFactor*elapsed/s.Stability, fsrsDecay)
+	}
+
+	if s.Interval <= 0 {
+		return 0
+	}
+
+	return math.Pow(fsrsRetention, elapsed/s.Interval)
+}
+
+// Scheduler calculates the next state of a schedule after a result on the scorer's -10..10 scale
+type Scheduler interface {
+	Review(schedule Schedule, result int, now time.Time) Schedule
+}
+
+// GetScheduler returns the scheduler of an algorithm, SM-2 by default
+func GetScheduler(algorithm string) Scheduler {
+	if algorithm == AlgorithmFSRS {
+		return FSRS{}
+	}
+
+	return SM2{}
+}
+
+// addDays returns the time after an interval given in days
+func addDays(now time.Time, days float64) time.Time {
+	return now.Add(time.Duration(days * float64(oneDay) * float64(time.Second)))
+}
+
+/**
+ * SM-2
+ */
+
+const (
+	sm2InitialEase = 2.5
+	sm2MinEase     = 1.3
+	// sm2PassingQuality is the lowest quality of a successful recall
+	sm2PassingQuality = 3
+)
+
+// SM2 is the SuperMemo 2 algorithm, results are turned into a 0-5 quality
+type SM2 struct{}
+
+func (sm2 SM2) Review(schedule Schedule, result int, now time.Time) Schedule {
+	quality := getQuality(result)
+
+	if schedule.Algorithm != AlgorithmSM2 || schedule.Ease == 0 {
+		schedule.Ease = sm2InitialEase
+	}
+
+	if quality >= sm2PassingQuality {
+		switch schedule.Streak {
+		case 0:
+			schedule.Interval = 1
+			break
+		case 1:
+			schedule.Interval = 6
+			break
+		default:
+			schedule.Interval = math.Round(schedule.Interval * schedule.Ease)
+		}
+
+		schedule.Streak++
+	} else {
+		if schedule.Reviews > 0 {
+			schedule.Lapses++
+		}
+
+		schedule.Streak = 0
+		schedule.Interval = 1
+	}
+
+	schedule.Ease += 0.1 - float64(5-quality)*(0.08+float64(5-quality)*0.02)
+	if schedule.Ease < sm2MinEase {
+		schedule.Ease = sm2MinEase
+	}
+
+	schedule.Algorithm = AlgorithmSM2
+	schedule.Reviews++
+	schedule.LastReview = now
+	schedule.Due = addDays(now, schedule.Interval)
+
+	return schedule
+}
+
+// getQuality turns a -10..10 result into an SM-2 quality between 0 and 5
+func getQuality(result int) int {
+	quality := result / 2
+
+	if quality < 0 {
+		return 0
+	}
+
+	if quality > 5 {
+		return 5
+	}
+
+	return quality
+}
+
+/**
+ * FSRS
+ */
+
+const (
+	ratingAgain = 1
+	ratingHard  = 2
+	ratingGood  = 3
+	ratingEasy  = 4
+
+	fsrsDecay  = -0.5
+	fsrsFactor = 19.0 / 81.0
+	// fsrsRetention is the probability of recall aimed for at the due date
+	fsrsRetention = 0.9
+)
+
+// fsrsWeights are the default parameters of FSRS v4.5
+var fsrsWeights = []float64{
+	0.4872, 1.4003, 3.7145, 13.8206, 5.1618, 1.2298, 0.8975, 0.031, 1.6474,
+	0.1367, 1.0461, 2.1072, 0.0793, 0.3246, 1.587, 0.2272, 2.8755,
+}
+
+// FSRS is a Free Spaced Repetition Scheduler style algorithm tracking the stability and difficulty of each word
+type FSRS struct{}
+
+func (fsrs FSRS) Review(schedule Schedule, result int, now time.Time) Schedule {
+	w := fsrsWeights
+	rating := getRating(result)
+
+	if schedule.Algorithm != AlgorithmFSRS {
+		schedule = fsrs.convert(schedule)
+	}
+
+	if schedule.Stability == 0 {
+		schedule.Stability = w[rating-1]
+		schedule.Difficulty = fsrs.initialDifficulty(rating)
+	} else {
+		elapsed := now.Sub(schedule.LastReview).Hours() / 24
+		if elapsed < 0 {
+			elapsed = 0
+		}
+
+		retrievability := math.Pow(1+fsrsFactor*elapsed/schedule.Stability, fsrsDecay)
+
+		if rating == ratingAgain {
+			stability := w[11] * math.Pow(schedule.Difficulty, -w[12]) * (math.Pow(schedule.Stability+1, w[13]) - 1) * math.Exp(w[14]*(1-retrievability))
+			schedule.Stability = math.Min(stability, schedule.Stability)
+			schedule.Lapses++
+		} else {
+			bonus := 1.0
+			if rating == ratingHard {
+				bonus = w[15]
+			} else if rating == ratingEasy {
+				bonus = w[16]
+			}
+
+			schedule.Stability *= 1 + math.Exp(w[8])*(11-schedule.Difficulty)*math.Pow(schedule.Stability, -w[9])*(math.Exp(w[10]*(1-retrievability))-1)*bonus
+		}
+
+		difficulty := schedule.Difficulty - w[6]*float64(rating-ratingGood)
+		schedule.Difficulty = clamp(w[7]*fsrs.initialDifficulty(ratingEasy)+(1-w[7])*difficulty, 1, 10)
+	}
+
+	if rating == ratingAgain {
+		schedule.Streak = 0
+	} else {
+		schedule.Streak++
+	}
+
+	schedule.Interval = math.Max(1, math.Round(schedule.Stability/fsrsFactor*(math.Pow(fsrsRetention, 1/fsrsDecay)-1)))
+	schedule.Algorithm = AlgorithmFSRS
+	schedule.Reviews++
+	schedule.LastReview = now
+	schedule.Due = addDays(now, schedule.Interval)
+
+	return schedule
+}
+
+func (fsrs FSRS) initialDifficulty(rating int) float64 {
+	return clamp(fsrsWeights[4]-float64(rating-ratingGood)*fsrsWeights[5], 1, 10)
+}
+
+// convert starts tracking stability and difficulty for words scheduled by another algorithm
+func (fsrs FSRS) convert(schedule Schedule) Schedule {
+	if schedule.IsNew() || schedule.Interval == 0 {
+		schedule.Stability = 0
+
+		return schedule
+	}
+
+	schedule.Stability = schedule.Interval
+	schedule.Difficulty = fsrs.initialDifficulty(ratingGood)
+
+	return schedule
+}
+
+// getRating turns a -10..10 result into an FSRS rating, games never send easy so a right answer is rated good
+func getRating(result int) int {
+	if result < 5 {
+		return ratingAgain
+	}
+
+	if result < 8 {
+		return ratingHard
+	}
+
+	return ratingGood
+}
+
+func clamp(value, min, max float64) float64 {
+	return math.Max(min, math.Min(max, value))
+}
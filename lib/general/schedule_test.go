@@ -0,0 +1,139 @@
+package general
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+var reviewStart = time.Date(2016, 10, 1, 12, 0, 0, 0, time.UTC)
+
+var sm2Cases = []struct {
+	results          []int
+	expectedInterval float64
+	expectedEase     float64
+	expectedStreak   int
+	expectedLapses   int
+}{
+	{[]int{10}, 1, 2.6, 1, 0},
+	{[]int{10, 10}, 6, 2.7, 2, 0},
+	{[]int{10, 10, 10}, 16, 2.8, 3, 0},
+	{[]int{10, 10, 0}, 1, 1.9, 0, 1},
+	{[]int{6}, 1, 2.36, 1, 0},
+	{[]int{0, 0, 0}, 1, 1.3, 0, 2},
+	{[]int{-10}, 1, 1.7, 0, 0},
+}
+
+func TestSM2Review(t *testing.T) {
+	for num, testCase := range sm2Cases {
+		schedule := Schedule{}
+		reviewedAt := reviewStart
+
+		for _, result := range testCase.results {
+			schedule = SM2{}.Review(schedule, result, reviewedAt)
+			reviewedAt = schedule.Due
+		}
+
+		if schedule.Interval != testCase.expectedInterval {
+			t.Fatalf("Case #%d: Expected interval %v, got: %v", num+1, testCase.expectedInterval, schedule.Interval)
+		}
+
+		if math.Abs(schedule.Ease-testCase.expectedEase) > 0.0001 {
+			t.Fatalf("Case #%d: Expected ease %v, got: %v", num+1, testCase.expectedEase, schedule.Ease)
+		}
+
+		if schedule.Streak != testCase.expectedStreak || schedule.Lapses != testCase.expectedLapses {
+			t.Fatalf("Case #%d: Expected streak %d and lapses %d, got: %d and %d", num+1, testCase.expectedStreak, testCase.expectedLapses, schedule.Streak, schedule.Lapses)
+		}
+
+		if schedule.Reviews != len(testCase.results) || schedule.Algorithm != AlgorithmSM2 {
+			t.Fatalf("Case #%d: Expected %d reviews with %s, got: %d with %s", num+1, len(testCase.results), AlgorithmSM2, schedule.Reviews, schedule.Algorithm)
+		}
+	}
+
+	t.Log(len(sm2Cases), "test cases")
+}
+
+func TestFSRSReview(t *testing.T) {
+	first := FSRS{}.Review(Schedule{}, 10, reviewStart)
+
+	if first.Stability != fsrsWeights[2] || first.Difficulty != fsrsWeights[4] {
+		t.Fatalf("Expected initial stability %v and difficulty %v, got: %v and %v", fsrsWeights[2], fsrsWeights[4], first.Stability, first.Difficulty)
+	}
+
+	if first.Interval != 4 || !first.Due.Equal(reviewStart.Add(4*24*time.Hour)) {
+		t.Fatalf("Expected a 4 day interval, got: %v days, due %v", first.Interval, first.Due)
+	}
+
+	good := FSRS{}.Review(first, 10, first.Due)
+	if good.Stability <= first.Stability || good.Interval <= first.Interval {
+		t.Fatalf("Expected growing stability and interval after a right answer, got: %v -> %v, %v -> %v", first.Stability, good.Stability, first.Interval, good.Interval)
+	}
+
+	hard := FSRS{}.Review(first, 6, first.Due)
+	if hard.Stability >= good.Stability || hard.Difficulty <= good.Difficulty {
+		t.Fatalf("Expected a hard answer to grow stability less and difficulty more, got: %v vs %v, %v vs %v", hard.Stability, good.Stability, hard.Difficulty, good.Difficulty)
+	}
+
+	again := FSRS{}.Review(good, 0, good.Due)
+	if again.Stability >= good.Stability || again.Lapses != 1 || again.Streak != 0 || again.Interval < 1 {
+		t.Fatalf("Expected shrinking stability and a lapse after a wrong answer, got: %v -> %v, lapses: %d, streak: %d, interval: %v", good.Stability, again.Stability, again.Lapses, again.Streak, again.Interval)
+	}
+
+	if again.Difficulty < 1 || again.Difficulty > 10 {
+		t.Fatalf("Expected difficulty between 1 and 10, got: %v", again.Difficulty)
+	}
+}
+
+func TestFSRSConvertsSM2Schedule(t *testing.T) {
+	schedule := SM2{}.Review(SM2{}.Review(Schedule{}, 10, reviewStart), 10, reviewStart.Add(24*time.Hour))
+
+	converted := FSRS{}.Review(schedule, 10, schedule.Due)
+
+	if converted.Algorithm != AlgorithmFSRS || converted.Stability <= schedule.Interval {
+		t.Fatalf("Expected an FSRS schedule with stability above %v, got: %s with %v", schedule.Interval, converted.Algorithm, converted.Stability)
+	}
+}
+
+var scheduleDueCases = []struct {
+	schedule      Schedule
+	expectedNew   bool
+	expectedIsDue bool
+}{
+	{Schedule{}, true, false},
+	{Schedule{Due: reviewStart.Add(-time.Hour)}, false, true},
+	{Schedule{Due: reviewStart}, false, true},
+	{Schedule{Due: reviewStart.Add(time.Hour)}, false, false},
+}
+
+func TestScheduleIsDue(t *testing.T) {
+	for num, testCase := range scheduleDueCases {
+		if testCase.schedule.IsNew() != testCase.expectedNew || testCase.schedule.IsDue(reviewStart) != testCase.expectedIsDue {
+			t.Fatalf("Case #%d: Expected new: %v, due: %v", num+1, testCase.expectedNew, testCase.expectedIsDue)
+		}
+	}
+
+	t.Log(len(scheduleDueCases), "test cases")
+}
+
+func TestRetrievabilityDropsToRetentionAtDue(t *testing.T) {
+	schedulers := []Scheduler{SM2{}, FSRS{}}
+
+	for num, scheduler := range schedulers {
+		schedule := scheduler.Review(scheduler.Review(Schedule{}, 10, reviewStart), 10, reviewStart.Add(24*time.Hour))
+
+		if schedule.Retrievability(schedule.LastReview) != 1 {
+			t.Fatalf("Case #%d: Expected full retrievability right after the review, got: %v", num+1, schedule.Retrievability(schedule.LastReview))
+		}
+
+		if math.Abs(schedule.Retrievability(schedule.Due)-fsrsRetention) > 0.02 {
+			t.Fatalf("Case #%d: Expected retrievability of about %v at the due date, got: %v", num+1, fsrsRetention, schedule.Retrievability(schedule.Due))
+		}
+	}
+
+	if (Schedule{}).Retrievability(reviewStart) != 0 {
+		t.Fatalf("Expected words never reviewed to have no retrievability")
+	}
+
+	t.Log(len(schedulers)+1, "test cases")
+}
@@ -17,9 +17,14 @@ const (
 )
 
 func GetLearnedAtScore(learnedAt time.Time) int64 {
+	return GetLearnedAtScoreAt(learnedAt, time.Now())
+}
+
+// GetLearnedAtScoreAt calculates the learned at score as it was at a given time
+func GetLearnedAtScoreAt(learnedAt, now time.Time) int64 {
 	var timeDelta int64
 
-	timeDelta = time.Now().Unix() - learnedAt.Unix()
+	timeDelta = now.Unix() - learnedAt.Unix()
 
 	if timeDelta/twoMonths > 6 {
 		return 6
@@ -29,16 +34,19 @@ func GetLearnedAtScore(learnedAt time.Time) int64 {
 }
 
 func GetProgressScore(scores []*Score) int64 {
+	return GetProgressScoreAt(scores, time.Now())
+}
+
+// GetProgressScoreAt calculates the progress score as it was at a given time
+func GetProgressScoreAt(scores []*Score, now time.Time) int64 {
 	var (
 		learnedScore, progressScore float64
-		now, timeDelta, hours, days int64
+		timeDelta, hours, days      int64
 		currentScore                int64
 	)
 
-	now = time.Now().Unix()
-
 	for _, score := range scores {
-		timeDelta = now - score.LearnedAt.Unix()
+		timeDelta = now.Unix() - score.LearnedAt.Unix()
 		hours = int64(timeDelta / oneHour)
 		days = int64(timeDelta / oneDay)
 		currentScore = int64(score.Result - 5)
@@ -59,6 +67,33 @@ func GetProgressScore(scores []*Score) int64 {
 	return int64(progressScore)
 }
 
+// difficultyWindow is the number of latest results considered for difficulty
+const difficultyWindow = 5
+
+// GetDifficulty returns a 0-10 difficulty based on the average of the latest results, words never scored are of medium difficulty
+func GetDifficulty(scores []*Score) float64 {
+	var (
+		sum   int
+		count int
+	)
+
+	for i := len(scores) - 1; i >= 0 && count < difficultyWindow; i-- {
+		sum += scores[i].Result
+		count++
+	}
+
+	if count == 0 {
+		return 5
+	}
+
+	difficulty := 10 - float64(sum)/float64(count)
+	if difficulty > 10 {
+		return 10
+	}
+
+	return difficulty
+}
+
 func GetRandomScore() int64 {
 	return rand.Int63n(10)
 }
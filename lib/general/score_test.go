@@ -18,21 +18,21 @@ var progressScoreCases = []struct {
 	// 1 point, now
 	{
 		[]Score{
-			Score{1, now},
+			Score{Result: 1, LearnedAt: now},
 		},
 		-100,
 	},
 	// 10 points, 299 second ago
 	{
 		[]Score{
-			Score{10, now.Add(-299 * time.Second)},
+			Score{Result: 10, LearnedAt: now.Add(-299 * time.Second)},
 		},
 		-100,
 	},
 	// 10 points, 301 second ago
 	{
 		[]Score{
-			Score{10, now.Add(-301 * time.Second)},
+			Score{Result: 10, LearnedAt: now.Add(-301 * time.Second)},
 		},
 		-25,
 	},
@@ -40,42 +40,42 @@ var progressScoreCases = []struct {
 	{
 		[]Score{
 			// -4 * 3
-			Score{1, now.Add(-2 * time.Hour)},
+			Score{Result: 1, LearnedAt: now.Add(-2 * time.Hour)},
 		},
 		12,
 	},
 	// 1 point, 3 hours 57 minutes ago
 	{
 		[]Score{
-			Score{1, now.Add(-3*time.Hour - 57*time.Minute)},
+			Score{Result: 1, LearnedAt: now.Add(-3*time.Hour - 57*time.Minute)},
 		},
 		8,
 	},
 	// 1 point, -8 hours ago
 	{
 		[]Score{
-			Score{1, now.Add(-8 * time.Hour)},
+			Score{Result: 1, LearnedAt: now.Add(-8 * time.Hour)},
 		},
 		4,
 	},
 	// 1 point, 1 day and 1 hour ago
 	{
 		[]Score{
-			Score{1, now.Add(-25 * time.Hour)},
+			Score{Result: 1, LearnedAt: now.Add(-25 * time.Hour)},
 		},
 		3,
 	},
 	// 10 points, 3 days ago
 	{
 		[]Score{
-			Score{10, now.Add(-3 * 24 * time.Hour)},
+			Score{Result: 10, LearnedAt: now.Add(-3 * 24 * time.Hour)},
 		},
 		-3,
 	},
 	// 10 points, 5 weeks ago
 	{
 		[]Score{
-			Score{10, now.Add(-5 * 7 * 24 * time.Hour)},
+			Score{Result: 10, LearnedAt: now.Add(-5 * 7 * 24 * time.Hour)},
 		},
 		0,
 	},
@@ -83,17 +83,17 @@ var progressScoreCases = []struct {
 	{
 		[]Score{
 			// 5 * 0.1 = 0.5
-			Score{10, now.Add(-50 * 7 * 24 * time.Hour)},
-			Score{10, now.Add(-50 * 7 * 24 * time.Hour)},
-			Score{10, now.Add(-50 * 7 * 24 * time.Hour)},
-			Score{10, now.Add(-50 * 7 * 24 * time.Hour)},
-			Score{10, now.Add(-50 * 7 * 24 * time.Hour)},
-			Score{10, now.Add(-50 * 7 * 24 * time.Hour)},
-			Score{10, now.Add(-50 * 7 * 24 * time.Hour)},
-			Score{10, now.Add(-50 * 7 * 24 * time.Hour)},
-			Score{10, now.Add(-50 * 7 * 24 * time.Hour)},
-			Score{10, now.Add(-50 * 7 * 24 * time.Hour)},
-			Score{10, now.Add(-50 * 7 * 24 * time.Hour)},
+			Score{Result: 10, LearnedAt: now.Add(-50 * 7 * 24 * time.Hour)},
+			Score{Result: 10, LearnedAt: now.Add(-50 * 7 * 24 * time.Hour)},
+			Score{Result: 10, LearnedAt: now.Add(-50 * 7 * 24 * time.Hour)},
+			Score{Result: 10, LearnedAt: now.Add(-50 * 7 * 24 * time.Hour)},
+			Score{Result: 10, LearnedAt: now.Add(-50 * 7 * 24 * time.Hour)},
+			Score{Result: 10, LearnedAt: now.Add(-50 * 7 * 24 * time.Hour)},
+			Score{Result: 10, LearnedAt: now.Add(-50 * 7 * 24 * time.Hour)},
+			Score{Result: 10, LearnedAt: now.Add(-50 * 7 * 24 * time.Hour)},
+			Score{Result: 10, LearnedAt: now.Add(-50 * 7 * 24 * time.Hour)},
+			Score{Result: 10, LearnedAt: now.Add(-50 * 7 * 24 * time.Hour)},
+			Score{Result: 10, LearnedAt: now.Add(-50 * 7 * 24 * time.Hour)},
 		},
 		-5,
 	},
@@ -102,9 +102,9 @@ var progressScoreCases = []struct {
 	{
 		[]Score{
 			// -4 * 9 * 0.1 = -3.6
-			Score{1, now.Add(-30 * time.Hour)},
+			Score{Result: 1, LearnedAt: now.Add(-30 * time.Hour)},
 			// 5 * 3 = 15
-			Score{10, now.Add(-2 * time.Hour)},
+			Score{Result: 10, LearnedAt: now.Add(-2 * time.Hour)},
 		},
 		// 15 - 3.6 - 11.4
 		-11,
@@ -112,9 +112,9 @@ var progressScoreCases = []struct {
 	{
 		[]Score{
 			// -4 * 9 0.1 = -3.6
-			Score{1, now.Add(-30 * time.Hour)},
+			Score{Result: 1, LearnedAt: now.Add(-30 * time.Hour)},
 			// 100
-			Score{10, now.Add(-1 * time.Second)},
+			Score{Result: 10, LearnedAt: now.Add(-1 * time.Second)},
 		},
 		-96,
 	},
@@ -148,6 +148,36 @@ func TestGetProgressScore(t *testing.T) {
 	t.Log(len(progressScoreCases), "test cases")
 }
 
+var difficultyCases = []struct {
+	results            []int
+	expectedDifficulty float64
+}{
+	{[]int{}, 5},
+	{[]int{10}, 0},
+	{[]int{0}, 10},
+	{[]int{-10}, 10},
+	{[]int{6, 8}, 3},
+	{[]int{0, 10, 10, 10, 10, 10}, 0},
+}
+
+func TestGetDifficulty(t *testing.T) {
+	for num, testCase := range difficultyCases {
+		scores := []*Score{}
+
+		for _, result := range testCase.results {
+			scores = append(scores, &Score{Result: result, LearnedAt: now})
+		}
+
+		actualDifficulty := GetDifficulty(scores)
+
+		if testCase.expectedDifficulty != actualDifficulty {
+			t.Fatalf("#%d. Expected difficulty: %v, got: %v.", num, testCase.expectedDifficulty, actualDifficulty)
+		}
+	}
+
+	t.Log(len(difficultyCases), "test cases")
+}
+
 func TestGetRandomScore(t *testing.T) {
 	var rand = GetRandomScore()
 
@@ -158,6 +188,19 @@ func TestGetRandomScore(t *testing.T) {
 	t.Log(1, "test case")
 }
 
+func TestGetProgressScoreAtUsesGivenTime(t *testing.T) {
+	scores := []*Score{&Score{Result: 1, LearnedAt: now}}
+
+	// -4 * 3
+	actualScore := GetProgressScoreAt(scores, now.Add(2*time.Hour))
+
+	if actualScore != 12 {
+		t.Fatalf("Progress score is different from expected. Expected: %v, got: %v.\n", 12, actualScore)
+	}
+
+	t.Log(1, "test case")
+}
+
 var learnedAtScoreCases = []struct {
 	learnedAt     time.Time
 	expectedScore int64
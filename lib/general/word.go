@@ -6,9 +6,15 @@ import (
 	"time"
 )
 
+// Score is a result given by a game, the details of the answer are optional, latency is the time taken to answer in milliseconds
 type Score struct {
-	Result    int       `bson:"result" json:"result"`
-	LearnedAt time.Time `bson:"learned_at" json:"learned_at"`
+	Result       int       `bson:"result" json:"result"`
+	LearnedAt    time.Time `bson:"learned_at" json:"learned_at"`
+	Game         string    `bson:"game,omitempty" json:"game,omitempty"`
+	QuestionType string    `bson:"question_type,omitempty" json:"question_type,omitempty"`
+	Answer       string    `bson:"answer,omitempty" json:"answer,omitempty"`
+	Right        []string  `bson:"right,omitempty" json:"right,omitempty"`
+	Latency      int64     `bson:"latency,omitempty" json:"latency,omitempty"`
 }
 
 func NewScore(result int) (*Score, error) {
@@ -16,7 +22,7 @@ func NewScore(result int) (*Score, error) {
 		return nil, errors.New(fmt.Sprintf("Result must be between -10 and 10, got %d", result))
 	}
 
-	return &Score{result, time.Now()}, nil
+	return &Score{Result: result, LearnedAt: time.Now()}, nil
 }
 
 type Word interface {
@@ -0,0 +1,214 @@
+package german
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/peteraba/d5/lib/german/entity"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// WordParser creates a word from the columns of a dictionary row, nil is returned if parsing failed
+type WordParser func(articleOrAuxiliary, german string, meanings map[string]string, category, user, learned, score, tags string) entity.Word
+
+// Validator checks the raw article / auxiliary and german columns before parsing
+type Validator func(articleOrAuxiliary, german string) error
+
+type Category struct {
+	Name        string      `json:"name"`
+	Aliases     []string    `json:"aliases,omitempty"`
+	Description string      `json:"description,omitempty"`
+	Type        string      `json:"type"`
+	Parse       WordParser  `json:"-"`
+	Validators  []Validator `json:"-"`
+}
+
+var (
+	categories      = map[string]*Category{}
+	categoryAliases = map[string]string{}
+	categoryNames   = []string{}
+)
+
+func init() {
+	RegisterCategory(Category{
+		Name:        entity.CategoryNoun,
+		Aliases:     []string{"n"},
+		Description: "noun",
+		Type:        entity.TypeNoun,
+		Parse: func(articleOrAuxiliary, german string, meanings map[string]string, category, user, learned, score, tags string) entity.Word {
+			if noun := entity.NewNoun(articleOrAuxiliary, german, meanings, user, learned, score, tags); noun != nil {
+				return noun
+			}
+
+			return nil
+		},
+		Validators: []Validator{
+			GermanValidator(entity.NounRegexp),
+		},
+	})
+
+	RegisterCategory(Category{
+		Name:        entity.CategoryVerb,
+		Aliases:     []string{"v"},
+		Description: "verb",
+		Type:        entity.TypeVerb,
+		Parse: func(articleOrAuxiliary, german string, meanings map[string]string, category, user, learned, score, tags string) entity.Word {
+			if verb := entity.NewVerb(articleOrAuxiliary, german, meanings, user, learned, score, tags); verb != nil {
+				return verb
+			}
+
+			return nil
+		},
+		Validators: []Validator{
+			GermanValidator(entity.VerbRegexp),
+			ArticleOrAuxiliaryValidator(entity.AuxiliaryRegexp),
+		},
+	})
+
+	RegisterCategory(Category{
+		Name:        entity.CategoryAdjective,
+		Aliases:     []string{"adjective", "a"},
+		Description: "adjective",
+		Type:        entity.TypeAdjective,
+		Parse: func(articleOrAuxiliary, german string, meanings map[string]string, category, user, learned, score, tags string) entity.Word {
+			if adjective := entity.NewAdjective(german, meanings, user, learned, score, tags); adjective != nil {
+				return adjective
+			}
+
+			return nil
+		},
+		Validators: []Validator{
+			GermanValidator(entity.AdjectiveRegexp),
+		},
+	})
+
+	RegisterCategory(NewAnyCategory("adv", "adverb", "adverb"))
+	RegisterCategory(NewAnyCategory("prep", "preposition", "preposition"))
+	RegisterCategory(NewAnyCategory("pron", "pronoun", "pronoun"))
+	RegisterCategory(NewAnyCategory("conj", "conjunction", "conjunction"))
+	RegisterCategory(NewAnyCategory("exp", "expression", "expression"))
+	RegisterCategory(NewAnyCategory("idiom", "idiom"))
+	RegisterCategory(NewAnyCategory("init", "initialism", "abbreviation"))
+	RegisterCategory(NewAnyCategory("prefix", "prefix"))
+	RegisterCategory(NewAnyCategory("inter", "interjection", "interjection"))
+}
+
+// NewAnyCategory creates a category without special fields, its words are stored as entity.Any
+func NewAnyCategory(name, description string, aliases ...string) Category {
+	return Category{
+		Name:        name,
+		Aliases:     aliases,
+		Description: description,
+		Type:        entity.TypeAny,
+		Parse: func(articleOrAuxiliary, german string, meanings map[string]string, category, user, learned, score, tags string) entity.Word {
+			return entity.NewAny(german, meanings, category, user, learned, score, tags, []string{})
+		},
+	}
+}
+
+// GermanValidator checks the german column against a regular expression
+func GermanValidator(re *regexp.Regexp) Validator {
+	return func(articleOrAuxiliary, german string) error {
+		if !re.MatchString(german) {
+			return errors.New(fmt.Sprintf("German is invalid: %s", german))
+		}
+
+		return nil
+	}
+}
+
+// ArticleOrAuxiliaryValidator checks the optional article / auxiliary column against a regular expression
+func ArticleOrAuxiliaryValidator(re *regexp.Regexp) Validator {
+	return func(articleOrAuxiliary, german string) error {
+		if articleOrAuxiliary != "" && !re.MatchString(articleOrAuxiliary) {
+			return errors.New(fmt.Sprintf("Article or auxiliary is invalid: %s", articleOrAuxiliary))
+		}
+
+		return nil
+	}
+}
+
+// RegisterCategory makes a category and its aliases known, registering a name twice replaces the previous one
+func RegisterCategory(category Category) {
+	if _, ok := categories[category.Name]; !ok {
+		categoryNames = append(categoryNames, category.Name)
+	}
+
+	if category.Parse == nil {
+		category.Parse = NewAnyCategory(category.Name, category.Description).Parse
+	}
+
+	if category.Type == "" {
+		category.Type = entity.TypeAny
+	}
+
+	categories[category.Name] = &category
+
+	categoryAliases[category.Name] = category.Name
+	for _, alias := range category.Aliases {
+		categoryAliases[strings.ToLower(alias)] = category.Name
+	}
+}
+
+// GetCategory finds a category by its name or one of its aliases
+func GetCategory(name string) (*Category, bool) {
+	canonical, ok := categoryAliases[strings.ToLower(strings.Trim(name, " "))]
+	if !ok {
+		return nil, false
+	}
+
+	return categories[canonical], true
+}
+
+// GetCategories returns the canonical list of categories in order of registration
+func GetCategories() []Category {
+	result := []Category{}
+
+	for _, name := range categoryNames {
+		result = append(result, *categories[name])
+	}
+
+	return result
+}
+
+// CanonicalCategory returns the canonical name of a category, unknown categories are returned unchanged
+func CanonicalCategory(name string) string {
+	if category, ok := GetCategory(name); ok {
+		return category.Name
+	}
+
+	return name
+}
+
+// CategoryType returns the word type used for a category, unknown categories are stored as any
+func CategoryType(name string) string {
+	if category, ok := GetCategory(name); ok {
+		return category.Type
+	}
+
+	return entity.TypeAny
+}
+
+// CategoryQuery returns a query matching the canonical name of a category and all its aliases
+func CategoryQuery(name string) interface{} {
+	category, ok := GetCategory(name)
+	if !ok {
+		return name
+	}
+
+	return bson.M{"$in": append([]string{category.Name}, category.Aliases...)}
+}
+
+func (c *Category) Validate(articleOrAuxiliary, german string) []string {
+	errors := []string{}
+
+	for _, validator := range c.Validators {
+		if err := validator(articleOrAuxiliary, german); err != nil {
+			errors = append(errors, err.Error())
+		}
+	}
+
+	return errors
+}
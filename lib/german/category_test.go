@@ -0,0 +1,125 @@
+package german
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/peteraba/d5/lib/german/entity"
+)
+
+var getCategoryCases = []struct {
+	name, canonical string
+	found           bool
+}{
+	{"noun", "noun", true},
+	{"adj", "adj", true},
+	{"adjective", "adj", true},
+	{" Adjective ", "adj", true},
+	{"prep", "prep", true},
+	{"preposition", "prep", true},
+	{"hello", "hello", false},
+}
+
+func TestGetCategory(t *testing.T) {
+	for num, testCase := range getCategoryCases {
+		category, found := GetCategory(testCase.name)
+
+		if found != testCase.found {
+			t.Fatalf("Case #%d: Expected found to be %v, got %v.", num+1, testCase.found, found)
+		}
+
+		if found && category.Name != testCase.canonical {
+			t.Fatalf("Case #%d: Expected: %s, got: %s.", num+1, testCase.canonical, category.Name)
+		}
+
+		if CanonicalCategory(testCase.name) != testCase.canonical && found {
+			t.Fatalf("Case #%d: Expected canonical: %s, got: %s.", num+1, testCase.canonical, CanonicalCategory(testCase.name))
+		}
+	}
+
+	t.Log(len(getCategoryCases), "test cases")
+}
+
+func TestGetCategoriesStartsWithSpecialCategories(t *testing.T) {
+	names := []string{}
+
+	for _, category := range GetCategories()[0:3] {
+		names = append(names, category.Name)
+	}
+
+	if !reflect.DeepEqual(names, []string{"noun", "verb", "adj"}) {
+		t.Fatalf("Unexpected categories: %v", names)
+	}
+}
+
+var categoryValidationCases = []struct {
+	category, articleOrAuxiliary, german string
+	errorCount                           int
+}{
+	{"noun", "r", "Tisch,⍨e", 0},
+	{"noun", "r", "tisch", 1},
+	{"verb", "h", "machen", 0},
+	{"verb", "x", "machen", 1},
+	{"adj", "", "schmal,~er/⍨er,~sten/⍨sten", 0},
+	{"adj", "", "Schmal", 1},
+	{"exp", "", "passt schon", 0},
+}
+
+func TestCategoryValidate(t *testing.T) {
+	for num, testCase := range categoryValidationCases {
+		category, _ := GetCategory(testCase.category)
+
+		errors := category.Validate(testCase.articleOrAuxiliary, testCase.german)
+		if len(errors) != testCase.errorCount {
+			t.Fatalf("Case #%d: Expected %d errors, got: %v", num+1, testCase.errorCount, errors)
+		}
+	}
+
+	t.Log(len(categoryValidationCases), "test cases")
+}
+
+func TestParseWordsResolvesAliases(t *testing.T) {
+	words := parseLegacyDocuments(t, newLegacyDocument("adjective"))
+
+	if _, ok := words[0].(*entity.Adjective); !ok {
+		t.Fatalf("Expected an adjective, got: %T", words[0])
+	}
+}
+
+func TestRegisterCategory(t *testing.T) {
+	RegisterCategory(Category{Name: "particle", Aliases: []string{"part"}})
+
+	category, ok := GetCategory("part")
+	if !ok || category.Name != "particle" {
+		t.Fatal("Registered category was not found by its alias.")
+	}
+
+	if category.Type != entity.TypeAny {
+		t.Fatalf("Expected categories without type to be stored as any, got: %s", category.Type)
+	}
+
+	words := parseLegacyDocuments(t, newLegacyDocument("part"))
+	if _, ok := words[0].(*entity.Any); !ok {
+		t.Fatalf("Expected words of categories without type to be decoded as any, got: %T", words[0])
+	}
+
+	dictionary := WordsToDictionary(words)
+	if len(dictionary.Words["particle"]) != 1 {
+		t.Fatalf("Expected the word to be added to the dictionary, got: %v", dictionary.Words)
+	}
+}
+
+func parseLegacyDocuments(t *testing.T, documents ...interface{}) []entity.Word {
+	b, err := json.Marshal(documents)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	words, err := ParseWords(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return words
+}
@@ -0,0 +1,178 @@
+package german
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/peteraba/d5/lib/german/entity"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// DictionaryAdder adds a word to the right list of a dictionary
+type DictionaryAdder func(dictionary *Dictionary, word entity.Word)
+
+// WordType describes how words stored with a given type discriminator are decoded
+type WordType struct {
+	Name            string
+	New             func() entity.Word
+	AddToDictionary DictionaryAdder
+}
+
+// documentHeader contains the fields needed to decide how a stored word is decoded
+type documentHeader struct {
+	entity.Envelope `bson:",inline"`
+	Word            struct {
+		Category string `bson:"category" json:"category"`
+	} `bson:"word" json:"word"`
+}
+
+var (
+	wordTypes = map[string]WordType{}
+)
+
+func init() {
+	RegisterType(WordType{
+		Name: entity.TypeNoun,
+		New: func() entity.Word {
+			return &entity.Noun{}
+		},
+		AddToDictionary: func(dictionary *Dictionary, word entity.Word) {
+			dictionary.Nouns = append(dictionary.Nouns, *word.(*entity.Noun))
+		},
+	})
+
+	RegisterType(WordType{
+		Name: entity.TypeVerb,
+		New: func() entity.Word {
+			return &entity.Verb{}
+		},
+		AddToDictionary: func(dictionary *Dictionary, word entity.Word) {
+			dictionary.Verbs = append(dictionary.Verbs, *word.(*entity.Verb))
+		},
+	})
+
+	RegisterType(WordType{
+		Name: entity.TypeAdjective,
+		New: func() entity.Word {
+			return &entity.Adjective{}
+		},
+		AddToDictionary: func(dictionary *Dictionary, word entity.Word) {
+			dictionary.Adjectives = append(dictionary.Adjectives, *word.(*entity.Adjective))
+		},
+	})
+
+	RegisterType(WordType{
+		Name: entity.TypeAny,
+		New: func() entity.Word {
+			return &entity.Any{}
+		},
+		AddToDictionary: func(dictionary *Dictionary, word entity.Word) {
+			cat := CanonicalCategory(word.GetCategory())
+
+			dictionary.Words[cat] = append(dictionary.Words[cat], *word.(*entity.Any))
+		},
+	})
+}
+
+// RegisterType makes a type discriminator known to the decoders
+func RegisterType(wordType WordType) {
+	wordTypes[wordType.Name] = wordType
+}
+
+// newWord creates an empty word for a document, documents written before types were introduced are typed by their category
+func newWord(header documentHeader) (entity.Word, error) {
+	if header.Schema > entity.SchemaVersion {
+		return nil, errors.New(fmt.Sprintf("Unsupported schema version: %d", header.Schema))
+	}
+
+	name := header.Type
+	if name == "" {
+		name = CategoryType(header.Word.Category)
+	}
+
+	wordType, ok := wordTypes[name]
+	if !ok {
+		return nil, errors.New(fmt.Sprintf("Unknown word type: %s", name))
+	}
+
+	return wordType.New(), nil
+}
+
+func DecodeJSONWord(document []byte) (entity.Word, error) {
+	var (
+		header = documentHeader{}
+		word   entity.Word
+		err    error
+	)
+
+	if err = json.Unmarshal(document, &header); err != nil {
+		return nil, err
+	}
+
+	if word, err = newWord(header); err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(document, word); err != nil {
+		return nil, err
+	}
+
+	return word, nil
+}
+
+func DecodeJSONWords(documents []json.RawMessage) ([]entity.Word, error) {
+	var (
+		words = []entity.Word{}
+	)
+
+	for _, document := range documents {
+		word, err := DecodeJSONWord(document)
+		if err != nil {
+			return []entity.Word{}, err
+		}
+
+		words = append(words, word)
+	}
+
+	return words, nil
+}
+
+func DecodeBSONWord(document bson.Raw) (entity.Word, error) {
+	var (
+		header = documentHeader{}
+		word   entity.Word
+		err    error
+	)
+
+	if err = document.Unmarshal(&header); err != nil {
+		return nil, err
+	}
+
+	if word, err = newWord(header); err != nil {
+		return nil, err
+	}
+
+	if err = document.Unmarshal(word); err != nil {
+		return nil, err
+	}
+
+	return word, nil
+}
+
+func DecodeBSONWords(documents []bson.Raw) ([]entity.Word, error) {
+	var (
+		words = []entity.Word{}
+	)
+
+	for _, document := range documents {
+		word, err := DecodeBSONWord(document)
+		if err != nil {
+			return []entity.Word{}, err
+		}
+
+		words = append(words, word)
+	}
+
+	return words, nil
+}
@@ -0,0 +1,109 @@
+package german
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/peteraba/d5/lib/german/entity"
+	"gopkg.in/mgo.v2/bson"
+)
+
+var encodingCases = []struct {
+	word     entity.Word
+	wordType string
+}{
+	{
+		entity.NewNoun("r", "Tisch,⍨e", map[string]string{entity.LangEnglish: "table"}, "peter", "2016-10-01", "5", ""),
+		entity.TypeNoun,
+	},
+	{
+		entity.NewVerb("h", "machen", map[string]string{entity.LangEnglish: "to do"}, "peter", "2016-10-01", "5", ""),
+		entity.TypeVerb,
+	},
+	{
+		entity.NewAdjective("schmal,~er/⍨er,~sten/⍨sten", map[string]string{entity.LangEnglish: "narrow"}, "peter", "2016-10-01", "5", ""),
+		entity.TypeAdjective,
+	},
+	{
+		entity.NewAny("passt schon", map[string]string{entity.LangEnglish: "it's ok"}, "exp", "peter", "2016-10-01", "5", "", []string{}),
+		entity.TypeAny,
+	},
+	{
+		entity.NewAny("Tisch", map[string]string{entity.LangEnglish: "table"}, "noun", "peter", "2016-10-01", "5", "", []string{"Parsing failed."}),
+		entity.TypeAny,
+	},
+}
+
+func TestEncodeJSON(t *testing.T) {
+	for num, testCase := range encodingCases {
+		b, err := json.Marshal(testCase.word)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		header := documentHeader{}
+		if err = json.Unmarshal(b, &header); err != nil {
+			t.Fatal(err)
+		}
+
+		if header.Type != testCase.wordType || header.Schema != entity.SchemaVersion {
+			t.Fatalf("Case #%d: Expected type %s and schema %d, got: %v", num+1, testCase.wordType, entity.SchemaVersion, header.Envelope)
+		}
+
+		word, err := DecodeJSONWord(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		decoded, err := json.Marshal(word)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(decoded) != string(b) {
+			t.Fatalf("Case #%d: Expected: %s, got: %s", num+1, b, decoded)
+		}
+	}
+
+	t.Log(len(encodingCases), "test cases")
+}
+
+func TestEncodeBSON(t *testing.T) {
+	for num, testCase := range encodingCases {
+		b, err := bson.Marshal(testCase.word)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		word, err := DecodeBSONWord(bson.Raw{Kind: 3, Data: b})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if word.GetType() != testCase.wordType {
+			t.Fatalf("Case #%d: Expected type: %s, got: %s", num+1, testCase.wordType, word.GetType())
+		}
+
+		if word.GetGerman() != testCase.word.GetGerman() {
+			t.Fatalf("Case #%d: Expected: %s, got: %s", num+1, testCase.word.GetGerman(), word.GetGerman())
+		}
+	}
+
+	t.Log(len(encodingCases), "test cases")
+}
+
+var decodingErrorCases = []string{
+	`{"type": "particle", "schema": 1, "word": {"german": "ja"}}`,
+	`{"type": "noun", "schema": 2, "word": {"german": "Tisch"}}`,
+	`{"type": "noun", "schema": 1, "word": {"german": 1}}`,
+}
+
+func TestDecodeJSONWordErrors(t *testing.T) {
+	for num, testCase := range decodingErrorCases {
+		if _, err := DecodeJSONWord([]byte(testCase)); err == nil {
+			t.Fatalf("Case #%d: Expected an error for: %s", num+1, testCase)
+		}
+	}
+
+	t.Log(len(decodingErrorCases), "test cases")
+}
@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"encoding/json"
 	"regexp"
 	"strings"
 
@@ -33,12 +34,12 @@ const (
 
 var (
 	// Adjective:
-	// ^                                                       -- match beginning of string
-	//  ([a-zäöüß]+)                                           -- match adjective
-	//              (,([a-zäöüß~⍨-]*))?                        -- match optional comparative, can be an extension only starting with a ⍨, ~
-	//                                 (,([a-zäöüß~⍨-]*))?     -- match optional superlative, can be an extension
-	//                                                    $    -- match end of string
-	AdjectiveRegexp = regexp.MustCompile("^([a-zäöüß]+)(,([a-zäöüß~⍨-]*))?(,([a-zäöüß~⍨-]*))?$")
+	// ^                                                         -- match beginning of string
+	//  ([a-zäöüß]+)                                             -- match adjective
+	//              (,([a-zäöüß~⍨/-]*))?                         -- match optional comparative, can be an extension only starting with a ⍨, ~
+	//                                  (,([a-zäöüß~⍨/-]*))?     -- match optional superlative, can be an extension
+	//                                                      $    -- match end of string
+	AdjectiveRegexp = regexp.MustCompile("^([a-zäöüß]+)(,([a-zäöüß~⍨/-]*))?(,([a-zäöüß~⍨/-]*))?$")
 )
 
 type Adjective struct {
@@ -48,7 +49,7 @@ type Adjective struct {
 	Id          bson.ObjectId `bson:"_id,omitempty" json:"_id,omitempty"`
 }
 
-func NewAdjective(german, english, third, user, learned, score, tags string) *Adjective {
+func NewAdjective(german string, meanings map[string]string, user, learned, score, tags string) *Adjective {
 	adjectiveParts := util.TrimSplit(german, conjugationSeparator)
 
 	if len(adjectiveParts) < 1 {
@@ -70,7 +71,7 @@ func NewAdjective(german, english, third, user, learned, score, tags string) *Ad
 	}
 
 	return &Adjective{
-		NewDefaultWord(german, english, third, "adjective", user, learned, score, tags, errors),
+		NewDefaultWord(german, meanings, CategoryAdjective, user, learned, score, tags, errors),
 		comparative,
 		superlative,
 		"",
@@ -89,6 +90,27 @@ func (a *Adjective) GetScores() []*general.Score {
 	return a.Scores
 }
 
+func (a *Adjective) GetType() string {
+	return TypeAdjective
+}
+
+func (a Adjective) encode() interface{} {
+	type adjective Adjective
+
+	return struct {
+		Envelope  `bson:",inline"`
+		adjective `bson:",inline"`
+	}{NewEnvelope(TypeAdjective), adjective(a)}
+}
+
+func (a Adjective) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.encode())
+}
+
+func (a Adjective) GetBSON() (interface{}, error) {
+	return a.encode(), nil
+}
+
 func (a *Adjective) GetComparative() []string {
 	result := []string{}
 	for _, comparative := range a.Comparative {
@@ -139,7 +161,10 @@ func (a *Adjective) Decline(
 		words = a.GetComparative()
 		break
 	default:
-		words = a.GetSuperlative()
+		// Superlatives are stored in their "am ...sten" form, the attributive stem has no -en
+		for _, superlative := range a.GetSuperlative() {
+			words = append(words, strings.TrimSuffix(superlative, "en"))
+		}
 	}
 
 	switch declension {
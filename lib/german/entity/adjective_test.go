@@ -42,8 +42,7 @@ func TestAdjectiveCreationSuccess(t *testing.T) {
 	for num, testCase := range adjectiveCreationSuccessCases {
 		adjective := NewAdjective(
 			testCase.german,
-			testCase.english,
-			testCase.third,
+			map[string]string{LangEnglish: testCase.english, LangHungarian: testCase.third},
 			testCase.user,
 			testCase.learned,
 			testCase.score,
@@ -70,8 +69,7 @@ func TestAdjectiveCreationFailure(t *testing.T) {
 	for num, testCase := range adjectiveCreationFailureCases {
 		adjective := NewAdjective(
 			testCase.german,
-			testCase.english,
-			testCase.third,
+			map[string]string{LangEnglish: testCase.english, LangHungarian: testCase.third},
 			testCase.user,
 			testCase.learned,
 			testCase.score,
@@ -12,6 +12,7 @@ var adjectiveRegexpSuccessCases = []struct {
 	{"erfolgsverwöhnt", "erfolgsverwöhnt", "", ""},
 	{"erfahren,-", "erfahren", "-", ""},
 	{"jung,⍨er,⍨sten", "jung", "⍨er", "⍨sten"},
+	{"schmal,~er/⍨er,~sten/⍨sten", "schmal", "~er/⍨er", "~sten/⍨sten"},
 }
 
 var adjectiveRegexpFailureCases = []string{
@@ -40,19 +41,24 @@ var adjectiveCreationSuccessCases = []struct {
 		&Adjective{
 			DefaultWord{
 				"ägyptisch",
-				[]Meaning{
-					Meaning{"Egyptian", ""},
+				map[string][]Meaning{
+					LangEnglish: []Meaning{
+						Meaning{[]string{"Egyptian"}, ""},
+					},
+					LangHungarian: []Meaning{
+						Meaning{[]string{"egyiptomi"}, ""},
+					},
 				},
-				[]Meaning{
-					Meaning{"egyiptomi", ""},
-				},
-				"adjective",
+				"adj",
 				"peteraba",
 				time.Date(2015, 5, 3, 0, 0, 0, 0, time.UTC),
 				5,
 				[]string{"object_from"},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]string{},
 			[]string{},
@@ -70,20 +76,25 @@ var adjectiveCreationSuccessCases = []struct {
 		&Adjective{
 			DefaultWord{
 				"andauernd",
-				[]Meaning{
-					Meaning{"continuous", ""},
-					Meaning{"ongoing", ""},
+				map[string][]Meaning{
+					LangEnglish: []Meaning{
+						Meaning{[]string{"continuous"}, ""},
+						Meaning{[]string{"ongoing"}, ""},
+					},
+					LangHungarian: []Meaning{
+						Meaning{[]string{"folyamatos"}, ""},
+					},
 				},
-				[]Meaning{
-					Meaning{"folyamatos", ""},
-				},
-				"adjective",
+				"adj",
 				"peteraba",
 				time.Date(2015, 5, 3, 0, 0, 0, 0, time.UTC),
 				5,
 				[]string{},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]string{"-"},
 			[]string{},
@@ -101,21 +112,26 @@ var adjectiveCreationSuccessCases = []struct {
 		&Adjective{
 			DefaultWord{
 				"aufmerksam",
-				[]Meaning{
-					Meaning{"alert, observant", "animal"},
-					Meaning{"kind, nice", ""},
+				map[string][]Meaning{
+					LangEnglish: []Meaning{
+						Meaning{[]string{"alert", "observant"}, "animal"},
+						Meaning{[]string{"kind", "nice"}, ""},
+					},
+					LangHungarian: []Meaning{
+						Meaning{[]string{"éber"}, "állat"},
+						Meaning{[]string{"figyelmes", "előzékeny"}, ""},
+					},
 				},
-				[]Meaning{
-					Meaning{"éber", "állat"},
-					Meaning{"figyelmes, előzékeny", ""},
-				},
-				"adjective",
+				"adj",
 				"peteraba",
 				time.Date(2015, 5, 3, 0, 0, 0, 0, time.UTC),
 				5,
 				[]string{"person", "animal"},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]string{},
 			[]string{},
@@ -133,19 +149,24 @@ var adjectiveCreationSuccessCases = []struct {
 		&Adjective{
 			DefaultWord{
 				"jung",
-				[]Meaning{
-					Meaning{"junior", ""},
-				},
-				[]Meaning{
-					Meaning{"kezdő", ""},
+				map[string][]Meaning{
+					LangEnglish: []Meaning{
+						Meaning{[]string{"junior"}, ""},
+					},
+					LangHungarian: []Meaning{
+						Meaning{[]string{"kezdő"}, ""},
+					},
 				},
-				"adjective",
+				"adj",
 				"peteraba",
 				time.Date(2015, 5, 3, 0, 0, 0, 0, time.UTC),
 				5,
 				[]string{"person"},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]string{"⍨er"},
 			[]string{"⍨sten"},
@@ -163,19 +184,24 @@ var adjectiveCreationSuccessCases = []struct {
 		&Adjective{
 			DefaultWord{
 				"schmal",
-				[]Meaning{
-					Meaning{"narrow", ""},
-				},
-				[]Meaning{
-					Meaning{"keskeny, szűk", ""},
+				map[string][]Meaning{
+					LangEnglish: []Meaning{
+						Meaning{[]string{"narrow"}, ""},
+					},
+					LangHungarian: []Meaning{
+						Meaning{[]string{"keskeny", "szűk"}, ""},
+					},
 				},
-				"adjective",
+				"adj",
 				"peteraba",
 				time.Date(2015, 5, 3, 0, 0, 0, 0, time.UTC),
 				5,
 				[]string{"room", "clothes"},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]string{"~er", "⍨er"},
 			[]string{"~sten", "⍨sten"},
@@ -208,8 +234,7 @@ var getComparativeCases = []struct {
 		Adjective{
 			DefaultWord{
 				"jung",
-				[]Meaning{},
-				[]Meaning{},
+				map[string][]Meaning{},
 				"",
 				"",
 				time.Now(),
@@ -217,6 +242,9 @@ var getComparativeCases = []struct {
 				[]string{},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]string{"⍨er"},
 			[]string{"⍨sten"},
@@ -238,8 +266,7 @@ var getSuperlativeCases = []struct {
 		Adjective{
 			DefaultWord{
 				"jung",
-				[]Meaning{},
-				[]Meaning{},
+				map[string][]Meaning{},
 				"",
 				"",
 				time.Now(),
@@ -247,6 +274,9 @@ var getSuperlativeCases = []struct {
 				[]string{},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]string{"⍨er"},
 			[]string{"⍨sten"},
@@ -261,8 +291,7 @@ var getSuperlativeCases = []struct {
 var declineAdjective = Adjective{
 	DefaultWord{
 		"neu",
-		[]Meaning{},
-		[]Meaning{},
+		map[string][]Meaning{},
 		"",
 		"",
 		time.Now(),
@@ -270,6 +299,9 @@ var declineAdjective = Adjective{
 		[]string{},
 		[]string{},
 		[]*general.Score{},
+		[]Example{},
+		"",
+		general.Schedule{},
 	},
 	[]string{"~er"},
 	[]string{"~sten"},
@@ -387,54 +419,54 @@ var declineAdjectiveCases = []struct {
 	{declineAdjective, Comparative, Weak, Der, true, CaseDative, "neueren"},
 	{declineAdjective, Comparative, Weak, Der, true, CaseGenitive, "neueren"},
 
-	{declineAdjective, Superlative, Strong, Der, false, CaseNominative, "neustener"},
-	{declineAdjective, Superlative, Strong, Der, false, CaseAcusative, "neustenen"},
-	{declineAdjective, Superlative, Strong, Der, false, CaseDative, "neustenem"},
-	{declineAdjective, Superlative, Strong, Der, false, CaseGenitive, "neustenen"},
-	{declineAdjective, Superlative, Strong, Das, false, CaseNominative, "neustenes"},
-	{declineAdjective, Superlative, Strong, Das, false, CaseAcusative, "neustenes"},
-	{declineAdjective, Superlative, Strong, Das, false, CaseDative, "neustenem"},
-	{declineAdjective, Superlative, Strong, Das, false, CaseGenitive, "neustenen"},
-	{declineAdjective, Superlative, Strong, Die, false, CaseNominative, "neustene"},
-	{declineAdjective, Superlative, Strong, Die, false, CaseAcusative, "neustene"},
-	{declineAdjective, Superlative, Strong, Die, false, CaseDative, "neustener"},
-	{declineAdjective, Superlative, Strong, Die, false, CaseGenitive, "neustener"},
-	{declineAdjective, Superlative, Strong, Der, true, CaseNominative, "neustene"},
-	{declineAdjective, Superlative, Strong, Der, true, CaseAcusative, "neustene"},
-	{declineAdjective, Superlative, Strong, Der, true, CaseDative, "neustenen"},
-	{declineAdjective, Superlative, Strong, Der, true, CaseGenitive, "neustener"},
+	{declineAdjective, Superlative, Strong, Der, false, CaseNominative, "neuster"},
+	{declineAdjective, Superlative, Strong, Der, false, CaseAcusative, "neusten"},
+	{declineAdjective, Superlative, Strong, Der, false, CaseDative, "neustem"},
+	{declineAdjective, Superlative, Strong, Der, false, CaseGenitive, "neusten"},
+	{declineAdjective, Superlative, Strong, Das, false, CaseNominative, "neustes"},
+	{declineAdjective, Superlative, Strong, Das, false, CaseAcusative, "neustes"},
+	{declineAdjective, Superlative, Strong, Das, false, CaseDative, "neustem"},
+	{declineAdjective, Superlative, Strong, Das, false, CaseGenitive, "neusten"},
+	{declineAdjective, Superlative, Strong, Die, false, CaseNominative, "neuste"},
+	{declineAdjective, Superlative, Strong, Die, false, CaseAcusative, "neuste"},
+	{declineAdjective, Superlative, Strong, Die, false, CaseDative, "neuster"},
+	{declineAdjective, Superlative, Strong, Die, false, CaseGenitive, "neuster"},
+	{declineAdjective, Superlative, Strong, Der, true, CaseNominative, "neuste"},
+	{declineAdjective, Superlative, Strong, Der, true, CaseAcusative, "neuste"},
+	{declineAdjective, Superlative, Strong, Der, true, CaseDative, "neusten"},
+	{declineAdjective, Superlative, Strong, Der, true, CaseGenitive, "neuster"},
 
-	{declineAdjective, Superlative, Mixed, Der, false, CaseNominative, "neustener"},
-	{declineAdjective, Superlative, Mixed, Der, false, CaseAcusative, "neustenen"},
-	{declineAdjective, Superlative, Mixed, Der, false, CaseDative, "neustenen"},
-	{declineAdjective, Superlative, Mixed, Der, false, CaseGenitive, "neustenen"},
-	{declineAdjective, Superlative, Mixed, Das, false, CaseNominative, "neustenes"},
-	{declineAdjective, Superlative, Mixed, Das, false, CaseAcusative, "neustenes"},
-	{declineAdjective, Superlative, Mixed, Das, false, CaseDative, "neustenen"},
-	{declineAdjective, Superlative, Mixed, Das, false, CaseGenitive, "neustenen"},
-	{declineAdjective, Superlative, Mixed, Die, false, CaseNominative, "neustene"},
-	{declineAdjective, Superlative, Mixed, Die, false, CaseAcusative, "neustene"},
-	{declineAdjective, Superlative, Mixed, Die, false, CaseDative, "neustenen"},
-	{declineAdjective, Superlative, Mixed, Die, false, CaseGenitive, "neustenen"},
-	{declineAdjective, Superlative, Mixed, Der, true, CaseNominative, "neustenen"},
-	{declineAdjective, Superlative, Mixed, Der, true, CaseAcusative, "neustenen"},
-	{declineAdjective, Superlative, Mixed, Der, true, CaseDative, "neustenen"},
-	{declineAdjective, Superlative, Mixed, Der, true, CaseGenitive, "neustenen"},
+	{declineAdjective, Superlative, Mixed, Der, false, CaseNominative, "neuster"},
+	{declineAdjective, Superlative, Mixed, Der, false, CaseAcusative, "neusten"},
+	{declineAdjective, Superlative, Mixed, Der, false, CaseDative, "neusten"},
+	{declineAdjective, Superlative, Mixed, Der, false, CaseGenitive, "neusten"},
+	{declineAdjective, Superlative, Mixed, Das, false, CaseNominative, "neustes"},
+	{declineAdjective, Superlative, Mixed, Das, false, CaseAcusative, "neustes"},
+	{declineAdjective, Superlative, Mixed, Das, false, CaseDative, "neusten"},
+	{declineAdjective, Superlative, Mixed, Das, false, CaseGenitive, "neusten"},
+	{declineAdjective, Superlative, Mixed, Die, false, CaseNominative, "neuste"},
+	{declineAdjective, Superlative, Mixed, Die, false, CaseAcusative, "neuste"},
+	{declineAdjective, Superlative, Mixed, Die, false, CaseDative, "neusten"},
+	{declineAdjective, Superlative, Mixed, Die, false, CaseGenitive, "neusten"},
+	{declineAdjective, Superlative, Mixed, Der, true, CaseNominative, "neusten"},
+	{declineAdjective, Superlative, Mixed, Der, true, CaseAcusative, "neusten"},
+	{declineAdjective, Superlative, Mixed, Der, true, CaseDative, "neusten"},
+	{declineAdjective, Superlative, Mixed, Der, true, CaseGenitive, "neusten"},
 
-	{declineAdjective, Superlative, Weak, Der, false, CaseNominative, "neustene"},
-	{declineAdjective, Superlative, Weak, Der, false, CaseAcusative, "neustenen"},
-	{declineAdjective, Superlative, Weak, Der, false, CaseDative, "neustenen"},
-	{declineAdjective, Superlative, Weak, Der, false, CaseGenitive, "neustenen"},
-	{declineAdjective, Superlative, Weak, Das, false, CaseNominative, "neustene"},
-	{declineAdjective, Superlative, Weak, Das, false, CaseAcusative, "neustene"},
-	{declineAdjective, Superlative, Weak, Das, false, CaseDative, "neustenen"},
-	{declineAdjective, Superlative, Weak, Das, false, CaseGenitive, "neustenen"},
-	{declineAdjective, Superlative, Weak, Die, false, CaseNominative, "neustene"},
-	{declineAdjective, Superlative, Weak, Die, false, CaseAcusative, "neustene"},
-	{declineAdjective, Superlative, Weak, Die, false, CaseDative, "neustenen"},
-	{declineAdjective, Superlative, Weak, Die, false, CaseGenitive, "neustenen"},
-	{declineAdjective, Superlative, Weak, Der, true, CaseNominative, "neustenen"},
-	{declineAdjective, Superlative, Weak, Der, true, CaseAcusative, "neustenen"},
-	{declineAdjective, Superlative, Weak, Der, true, CaseDative, "neustenen"},
-	{declineAdjective, Superlative, Weak, Der, true, CaseGenitive, "neustenen"},
+	{declineAdjective, Superlative, Weak, Der, false, CaseNominative, "neuste"},
+	{declineAdjective, Superlative, Weak, Der, false, CaseAcusative, "neusten"},
+	{declineAdjective, Superlative, Weak, Der, false, CaseDative, "neusten"},
+	{declineAdjective, Superlative, Weak, Der, false, CaseGenitive, "neusten"},
+	{declineAdjective, Superlative, Weak, Das, false, CaseNominative, "neuste"},
+	{declineAdjective, Superlative, Weak, Das, false, CaseAcusative, "neuste"},
+	{declineAdjective, Superlative, Weak, Das, false, CaseDative, "neusten"},
+	{declineAdjective, Superlative, Weak, Das, false, CaseGenitive, "neusten"},
+	{declineAdjective, Superlative, Weak, Die, false, CaseNominative, "neuste"},
+	{declineAdjective, Superlative, Weak, Die, false, CaseAcusative, "neuste"},
+	{declineAdjective, Superlative, Weak, Die, false, CaseDative, "neusten"},
+	{declineAdjective, Superlative, Weak, Die, false, CaseGenitive, "neusten"},
+	{declineAdjective, Superlative, Weak, Der, true, CaseNominative, "neusten"},
+	{declineAdjective, Superlative, Weak, Der, true, CaseAcusative, "neusten"},
+	{declineAdjective, Superlative, Weak, Der, true, CaseDative, "neusten"},
+	{declineAdjective, Superlative, Weak, Der, true, CaseGenitive, "neusten"},
 }
@@ -0,0 +1,49 @@
+package entity
+
+var newExamplesCases = []struct {
+	raw      string
+	examples []Example
+}{
+	{"", []Example{}},
+	{"Er geht.", []Example{Example{"Er geht.", ""}}},
+	{
+		"Ich gehe nach Hause. = I go home. | Er geht. = He goes.",
+		[]Example{
+			Example{"Ich gehe nach Hause.", "I go home."},
+			Example{"Er geht.", "He goes."},
+		},
+	},
+	{" | = nothing", []Example{}},
+}
+
+var clozeCases = []struct {
+	word     Word
+	examples []Example
+	cloze    []Cloze
+}{
+	{
+		NewNoun("r", "Tisch,⍨e", map[string]string{LangEnglish: "table"}, "", "", "", ""),
+		[]Example{Example{"Die Tische sind neu.", "The tables are new."}, Example{"Ein Tischler.", ""}},
+		[]Cloze{Cloze{"Die ___ sind neu.", "The tables are new.", "Tische"}},
+	},
+	{
+		NewVerb("h", "machen", map[string]string{LangEnglish: "to do"}, "", "", "", ""),
+		[]Example{Example{"Was machst du?", "What are you doing?"}},
+		[]Cloze{Cloze{"Was ___ du?", "What are you doing?", "machst"}},
+	},
+	{
+		NewAdjective("groß,⍨er,⍨ten", map[string]string{LangEnglish: "big"}, "", "", "", ""),
+		[]Example{Example{"Groß ist es nicht.", ""}, Example{"Großartig!", ""}},
+		[]Cloze{Cloze{"___ ist es nicht.", "", "Groß"}},
+	},
+	{
+		NewAdjective("groß,⍨er,⍨ten", map[string]string{LangEnglish: "big"}, "", "", "", ""),
+		[]Example{Example{"Das ist ein großes Haus.", ""}, Example{"Wir brauchen eine größere Wohnung.", ""}},
+		[]Cloze{Cloze{"Das ist ein ___ Haus.", "", "großes"}, Cloze{"Wir brauchen eine ___ Wohnung.", "", "größere"}},
+	},
+	{
+		NewNoun("r", "Tisch,⍨e", map[string]string{LangEnglish: "table"}, "", "", "", ""),
+		[]Example{Example{"Er sitzt an den Tischen.", ""}},
+		[]Cloze{Cloze{"Er sitzt an den ___.", "", "Tischen"}},
+	},
+}
@@ -43,11 +43,13 @@ var nounCreationSuccessCases = []struct {
 		&Noun{
 			DefaultWord{
 				"Hintergeräusch",
-				[]Meaning{
-					Meaning{"background noise", ""},
-				},
-				[]Meaning{
-					Meaning{"háttérzaj", ""},
+				map[string][]Meaning{
+					LangEnglish: []Meaning{
+						Meaning{[]string{"background noise"}, ""},
+					},
+					LangHungarian: []Meaning{
+						Meaning{[]string{"háttérzaj"}, ""},
+					},
 				},
 				"noun",
 				"peteraba",
@@ -56,6 +58,9 @@ var nounCreationSuccessCases = []struct {
 				[]string{"sound"},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]Article{Das},
 			[]string{"~e"},
@@ -76,11 +81,13 @@ var nounCreationSuccessCases = []struct {
 		&Noun{
 			DefaultWord{
 				"Jurastudium",
-				[]Meaning{
-					Meaning{"law studies", ""},
-				},
-				[]Meaning{
-					Meaning{"jogi tanulmány", ""},
+				map[string][]Meaning{
+					LangEnglish: []Meaning{
+						Meaning{[]string{"law studies"}, ""},
+					},
+					LangHungarian: []Meaning{
+						Meaning{[]string{"jogi tanulmány"}, ""},
+					},
 				},
 				"noun",
 				"peteraba",
@@ -89,6 +96,9 @@ var nounCreationSuccessCases = []struct {
 				[]string{"studies"},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]Article{Das},
 			[]string{"Jurastudien"},
@@ -109,12 +119,14 @@ var nounCreationSuccessCases = []struct {
 		&Noun{
 			DefaultWord{
 				"Nebel",
-				[]Meaning{
-					Meaning{"fog, mist, haze", ""},
-					Meaning{"nebula", "astronomy"},
-				},
-				[]Meaning{
-					Meaning{"köd", ""},
+				map[string][]Meaning{
+					LangEnglish: []Meaning{
+						Meaning{[]string{"fog", "mist", "haze"}, ""},
+						Meaning{[]string{"nebula"}, "astronomy"},
+					},
+					LangHungarian: []Meaning{
+						Meaning{[]string{"köd"}, ""},
+					},
 				},
 				"noun",
 				"peteraba",
@@ -123,6 +135,9 @@ var nounCreationSuccessCases = []struct {
 				[]string{"visible"},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]Article{Der},
 			[]string{"~"},
@@ -143,11 +158,13 @@ var nounCreationSuccessCases = []struct {
 		&Noun{
 			DefaultWord{
 				"Vereinigten Staaten von Amerika",
-				[]Meaning{
-					Meaning{"United States of America", ""},
-				},
-				[]Meaning{
-					Meaning{"Amerikai Egyesült Államok", ""},
+				map[string][]Meaning{
+					LangEnglish: []Meaning{
+						Meaning{[]string{"United States of America"}, ""},
+					},
+					LangHungarian: []Meaning{
+						Meaning{[]string{"Amerikai Egyesült Államok"}, ""},
+					},
 				},
 				"noun",
 				"peteraba",
@@ -156,6 +173,9 @@ var nounCreationSuccessCases = []struct {
 				[]string{"country"},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]Article{Die},
 			[]string{"-"},
@@ -176,8 +196,7 @@ var getPluralCases = []struct {
 		Noun{
 			DefaultWord{
 				"Gulasch",
-				[]Meaning{},
-				[]Meaning{},
+				map[string][]Meaning{},
 				"",
 				"",
 				time.Now(),
@@ -185,6 +204,9 @@ var getPluralCases = []struct {
 				[]string{},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]Article{},
 			[]string{"~s", "~e"},
@@ -200,8 +222,7 @@ var getPluralCases = []struct {
 		Noun{
 			DefaultWord{
 				"Klamotten",
-				[]Meaning{},
-				[]Meaning{},
+				map[string][]Meaning{},
 				"",
 				"",
 				time.Now(),
@@ -209,6 +230,9 @@ var getPluralCases = []struct {
 				[]string{},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]Article{},
 			[]string{},
@@ -224,8 +248,7 @@ var getPluralCases = []struct {
 		Noun{
 			DefaultWord{
 				"Jurastudium",
-				[]Meaning{},
-				[]Meaning{},
+				map[string][]Meaning{},
 				"",
 				"",
 				time.Now(),
@@ -233,6 +256,9 @@ var getPluralCases = []struct {
 				[]string{},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]Article{},
 			[]string{"Jurastudien"},
@@ -248,8 +274,7 @@ var getPluralCases = []struct {
 		Noun{
 			DefaultWord{
 				"Knast",
-				[]Meaning{},
-				[]Meaning{},
+				map[string][]Meaning{},
 				"",
 				"",
 				time.Now(),
@@ -257,6 +282,9 @@ var getPluralCases = []struct {
 				[]string{},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]Article{},
 			[]string{"⍨e"},
@@ -280,8 +308,7 @@ var getGenitiveCases = []struct {
 		Noun{
 			DefaultWord{
 				"Gulasch",
-				[]Meaning{},
-				[]Meaning{},
+				map[string][]Meaning{},
 				"",
 				"",
 				time.Now(),
@@ -289,6 +316,9 @@ var getGenitiveCases = []struct {
 				[]string{},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]Article{},
 			[]string{},
@@ -317,8 +347,7 @@ var getNounDeclensionCases = []struct {
 		Noun{
 			DefaultWord{
 				"Berg",
-				[]Meaning{},
-				[]Meaning{},
+				map[string][]Meaning{},
 				"",
 				"",
 				time.Now(),
@@ -326,6 +355,9 @@ var getNounDeclensionCases = []struct {
 				[]string{},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]Article{Der},
 			[]string{"~e"},
@@ -346,8 +378,7 @@ var getNounDeclensionCases = []struct {
 		Noun{
 			DefaultWord{
 				"Tag",
-				[]Meaning{},
-				[]Meaning{},
+				map[string][]Meaning{},
 				"",
 				"",
 				time.Now(),
@@ -355,6 +386,9 @@ var getNounDeclensionCases = []struct {
 				[]string{},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]Article{Der},
 			[]string{"~e"},
@@ -375,8 +409,7 @@ var getNounDeclensionCases = []struct {
 		Noun{
 			DefaultWord{
 				"Bedingung",
-				[]Meaning{},
-				[]Meaning{},
+				map[string][]Meaning{},
 				"",
 				"",
 				time.Now(),
@@ -384,6 +417,9 @@ var getNounDeclensionCases = []struct {
 				[]string{},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]Article{Die},
 			[]string{"~en"},
@@ -404,8 +440,7 @@ var getNounDeclensionCases = []struct {
 		Noun{
 			DefaultWord{
 				"Neffe",
-				[]Meaning{},
-				[]Meaning{},
+				map[string][]Meaning{},
 				"",
 				"",
 				time.Now(),
@@ -413,6 +448,9 @@ var getNounDeclensionCases = []struct {
 				[]string{},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]Article{Der},
 			[]string{"~n"},
@@ -433,8 +471,7 @@ var getNounDeclensionCases = []struct {
 		Noun{
 			DefaultWord{
 				"Prinz",
-				[]Meaning{},
-				[]Meaning{},
+				map[string][]Meaning{},
 				"",
 				"",
 				time.Now(),
@@ -442,6 +479,9 @@ var getNounDeclensionCases = []struct {
 				[]string{},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]Article{Der},
 			[]string{"~en"},
@@ -462,8 +502,7 @@ var getNounDeclensionCases = []struct {
 		Noun{
 			DefaultWord{
 				"Umsatz",
-				[]Meaning{},
-				[]Meaning{},
+				map[string][]Meaning{},
 				"",
 				"",
 				time.Now(),
@@ -471,6 +510,9 @@ var getNounDeclensionCases = []struct {
 				[]string{},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]Article{Der},
 			[]string{"⍨e"},
@@ -491,8 +533,7 @@ var getNounDeclensionCases = []struct {
 		Noun{
 			DefaultWord{
 				"Herz",
-				[]Meaning{},
-				[]Meaning{},
+				map[string][]Meaning{},
 				"",
 				"",
 				time.Now(),
@@ -500,6 +541,9 @@ var getNounDeclensionCases = []struct {
 				[]string{},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]Article{Das},
 			[]string{"~en"},
@@ -520,8 +564,7 @@ var getNounDeclensionCases = []struct {
 		Noun{
 			DefaultWord{
 				"Zug",
-				[]Meaning{},
-				[]Meaning{},
+				map[string][]Meaning{},
 				"",
 				"",
 				time.Now(),
@@ -529,6 +572,9 @@ var getNounDeclensionCases = []struct {
 				[]string{},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]Article{Der},
 			[]string{"⍨e"},
@@ -549,8 +595,7 @@ var getNounDeclensionCases = []struct {
 		Noun{
 			DefaultWord{
 				"Wurst",
-				[]Meaning{},
-				[]Meaning{},
+				map[string][]Meaning{},
 				"",
 				"",
 				time.Now(),
@@ -558,6 +603,9 @@ var getNounDeclensionCases = []struct {
 				[]string{},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]Article{Die},
 			[]string{"⍨e"},
@@ -578,8 +626,7 @@ var getNounDeclensionCases = []struct {
 		Noun{
 			DefaultWord{
 				"Elefant",
-				[]Meaning{},
-				[]Meaning{},
+				map[string][]Meaning{},
 				"",
 				"",
 				time.Now(),
@@ -587,6 +634,9 @@ var getNounDeclensionCases = []struct {
 				[]string{},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]Article{Der},
 			[]string{"~en"},
@@ -604,3 +654,19 @@ var getNounDeclensionCases = []struct {
 		[]string{"Elefanten"},
 	},
 }
+
+var declineWithArticleCases = []struct {
+	noun       *Noun
+	determiner string
+	isDefinite bool
+	isPlural   bool
+	nounCase   Case
+	expected   []string
+}{
+	{NewNoun("s", "Kind,⍨er", map[string]string{}, "", "", "", ""), "der", true, true, CaseDative, []string{"den Kindern"}},
+	{NewNoun("s", "Kind,⍨er", map[string]string{}, "", "", "", ""), "ein", false, false, CaseDative, []string{"einem Kind", "einem Kinde"}},
+	{NewNoun("s", "Kind,⍨er", map[string]string{}, "", "", "", ""), "ein", false, true, CaseNominative, []string{"Kinder"}},
+	{NewNoun("s", "Kind,⍨er", map[string]string{}, "", "", "", ""), "kein", false, true, CaseAcusative, []string{"keine Kinder"}},
+	{NewNoun("e", "Frau,⍨en", map[string]string{}, "", "", "", ""), "der", true, false, CaseGenitive, []string{"der Frau"}},
+	{NewNoun("r/s", "Teil,⍨e", map[string]string{}, "", "", "", ""), "der", true, false, CaseNominative, []string{"der Teil", "das Teil"}},
+}
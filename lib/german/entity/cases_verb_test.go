@@ -63,6 +63,19 @@ var argumentCreationCases = []struct {
 			Argument{"an", "D"},
 		},
 	},
+	{
+		"+ auf",
+		[]Argument{
+			Argument{"auf", ""},
+		},
+	},
+	{
+		"+ wegen (G) + mit",
+		[]Argument{
+			Argument{"wegen", "G"},
+			Argument{"mit", ""},
+		},
+	},
 }
 
 var prefixCreationCases = []struct {
@@ -120,11 +133,13 @@ var verbCreationSuccessCases = []struct {
 		&Verb{
 			DefaultWord{
 				"brechen",
-				[]Meaning{
-					Meaning{"to break, to get broken", ""},
-				},
-				[]Meaning{
-					Meaning{"összetörni, összetörik", ""},
+				map[string][]Meaning{
+					LangEnglish: []Meaning{
+						Meaning{[]string{"to break", "to get broken"}, ""},
+					},
+					LangHungarian: []Meaning{
+						Meaning{[]string{"összetörni", "összetörik"}, ""},
+					},
 				},
 				"verb",
 				"peteraba",
@@ -133,6 +148,9 @@ var verbCreationSuccessCases = []struct {
 				[]string{},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]Auxiliary{Haben},
 			Prefix{},
@@ -180,11 +198,13 @@ var verbCreationSuccessCases = []struct {
 		&Verb{
 			DefaultWord{
 				"durchfallen",
-				[]Meaning{
-					Meaning{"to check through", ""},
-				},
-				[]Meaning{
-					Meaning{"ellenőrizni, átvizsgálni", ""},
+				map[string][]Meaning{
+					LangEnglish: []Meaning{
+						Meaning{[]string{"to check through"}, ""},
+					},
+					LangHungarian: []Meaning{
+						Meaning{[]string{"ellenőrizni", "átvizsgálni"}, ""},
+					},
 				},
 				"verb",
 				"peteraba",
@@ -193,6 +213,9 @@ var verbCreationSuccessCases = []struct {
 				[]string{},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]Auxiliary{Haben},
 			Prefix{
@@ -243,12 +266,14 @@ var verbCreationSuccessCases = []struct {
 		&Verb{
 			DefaultWord{
 				"einfallen",
-				[]Meaning{
-					Meaning{"to come to mind", ""},
-					Meaning{"to remember", ""},
-				},
-				[]Meaning{
-					Meaning{"eszébe jut", ""},
+				map[string][]Meaning{
+					LangEnglish: []Meaning{
+						Meaning{[]string{"to come to mind"}, ""},
+						Meaning{[]string{"to remember"}, ""},
+					},
+					LangHungarian: []Meaning{
+						Meaning{[]string{"eszébe jut"}, ""},
+					},
 				},
 				"verb",
 				"peteraba",
@@ -257,6 +282,9 @@ var verbCreationSuccessCases = []struct {
 				[]string{},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]Auxiliary{Sein},
 			Prefix{
@@ -309,11 +337,13 @@ var verbCreationSuccessCases = []struct {
 		&Verb{
 			DefaultWord{
 				"fehlen",
-				[]Meaning{
-					Meaning{"to lack", ""},
-				},
-				[]Meaning{
-					Meaning{"hiányozni", ""},
+				map[string][]Meaning{
+					LangEnglish: []Meaning{
+						Meaning{[]string{"to lack"}, ""},
+					},
+					LangHungarian: []Meaning{
+						Meaning{[]string{"hiányozni"}, ""},
+					},
 				},
 				"verb",
 				"peteraba",
@@ -322,6 +352,9 @@ var verbCreationSuccessCases = []struct {
 				[]string{},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]Auxiliary{Haben},
 			Prefix{},
@@ -372,11 +405,13 @@ var verbCreationSuccessCases = []struct {
 		&Verb{
 			DefaultWord{
 				"tun",
-				[]Meaning{
-					Meaning{"to do", ""},
-				},
-				[]Meaning{
-					Meaning{"tenni, csinálni", ""},
+				map[string][]Meaning{
+					LangEnglish: []Meaning{
+						Meaning{[]string{"to do"}, ""},
+					},
+					LangHungarian: []Meaning{
+						Meaning{[]string{"tenni", "csinálni"}, ""},
+					},
 				},
 				"verb",
 				"peteraba",
@@ -385,6 +420,9 @@ var verbCreationSuccessCases = []struct {
 				[]string{},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]Auxiliary{Haben},
 			Prefix{},
@@ -432,11 +470,13 @@ var verbCreationSuccessCases = []struct {
 		&Verb{
 			DefaultWord{
 				"treiben",
-				[]Meaning{
-					Meaning{"to sport", ""},
-				},
-				[]Meaning{
-					Meaning{"sportolni", ""},
+				map[string][]Meaning{
+					LangEnglish: []Meaning{
+						Meaning{[]string{"to sport"}, ""},
+					},
+					LangHungarian: []Meaning{
+						Meaning{[]string{"sportolni"}, ""},
+					},
 				},
 				"verb",
 				"peteraba",
@@ -445,6 +485,9 @@ var verbCreationSuccessCases = []struct {
 				[]string{},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]Auxiliary{Haben},
 			Prefix{},
@@ -492,11 +535,13 @@ var verbCreationSuccessCases = []struct {
 		&Verb{
 			DefaultWord{
 				"sein",
-				[]Meaning{
-					Meaning{"to sneeze", ""},
-				},
-				[]Meaning{
-					Meaning{"tüsszenteni", ""},
+				map[string][]Meaning{
+					LangEnglish: []Meaning{
+						Meaning{[]string{"to sneeze"}, ""},
+					},
+					LangHungarian: []Meaning{
+						Meaning{[]string{"tüsszenteni"}, ""},
+					},
 				},
 				"verb",
 				"peteraba",
@@ -505,6 +550,9 @@ var verbCreationSuccessCases = []struct {
 				[]string{},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]Auxiliary{Sein},
 			Prefix{},
@@ -554,11 +602,13 @@ var verbCreationSuccessCases = []struct {
 		&Verb{
 			DefaultWord{
 				"verzweifeln",
-				[]Meaning{
-					Meaning{"to panic", ""},
-				},
-				[]Meaning{
-					Meaning{"kétségbeesni", ""},
+				map[string][]Meaning{
+					LangEnglish: []Meaning{
+						Meaning{[]string{"to panic"}, ""},
+					},
+					LangHungarian: []Meaning{
+						Meaning{[]string{"kétségbeesni"}, ""},
+					},
 				},
 				"verb",
 				"peteraba",
@@ -567,6 +617,9 @@ var verbCreationSuccessCases = []struct {
 				[]string{},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]Auxiliary{Sein},
 			Prefix{"ver", false},
@@ -614,11 +667,13 @@ var verbCreationSuccessCases = []struct {
 		&Verb{
 			DefaultWord{
 				"bewegen",
-				[]Meaning{
-					Meaning{"to persuade sb, to induce sb", ""},
-				},
-				[]Meaning{
-					Meaning{"rábírni vkit", "vmire"},
+				map[string][]Meaning{
+					LangEnglish: []Meaning{
+						Meaning{[]string{"to persuade sb", "to induce sb"}, ""},
+					},
+					LangHungarian: []Meaning{
+						Meaning{[]string{"rábírni vkit"}, "vmire"},
+					},
 				},
 				"verb",
 				"peteraba",
@@ -627,6 +682,9 @@ var verbCreationSuccessCases = []struct {
 				[]string{},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]Auxiliary{Haben},
 			Prefix{"be", false},
@@ -675,11 +733,13 @@ var verbCreationSuccessCases = []struct {
 		&Verb{
 			DefaultWord{
 				"sein",
-				[]Meaning{
-					Meaning{"to agree", ""},
-				},
-				[]Meaning{
-					Meaning{"beleegyezni, egyetérteni", ""},
+				map[string][]Meaning{
+					LangEnglish: []Meaning{
+						Meaning{[]string{"to agree"}, ""},
+					},
+					LangHungarian: []Meaning{
+						Meaning{[]string{"beleegyezni", "egyetérteni"}, ""},
+					},
 				},
 				"verb",
 				"peteraba",
@@ -688,6 +748,9 @@ var verbCreationSuccessCases = []struct {
 				[]string{},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]Auxiliary{Sein},
 			Prefix{"", false},
@@ -735,11 +798,13 @@ var verbCreationSuccessCases = []struct {
 		&Verb{
 			DefaultWord{
 				"geschehen",
-				[]Meaning{
-					Meaning{"to occur, to happen", "formal"},
-				},
-				[]Meaning{
-					Meaning{"történni", "formális"},
+				map[string][]Meaning{
+					LangEnglish: []Meaning{
+						Meaning{[]string{"to occur", "to happen"}, "formal"},
+					},
+					LangHungarian: []Meaning{
+						Meaning{[]string{"történni"}, "formális"},
+					},
 				},
 				"verb",
 				"peteraba",
@@ -748,6 +813,9 @@ var verbCreationSuccessCases = []struct {
 				[]string{},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]Auxiliary{Sein},
 			Prefix{"ge", false},
@@ -795,11 +863,13 @@ var verbCreationSuccessCases = []struct {
 		&Verb{
 			DefaultWord{
 				"sein",
-				[]Meaning{
-					Meaning{"to agree in sth, to have the same opinion on sth", ""},
-				},
-				[]Meaning{
-					Meaning{"egyetérteni vmiben, egy véleményen lenni", ""},
+				map[string][]Meaning{
+					LangEnglish: []Meaning{
+						Meaning{[]string{"to agree in sth", "to have the same opinion on sth"}, ""},
+					},
+					LangHungarian: []Meaning{
+						Meaning{[]string{"egyetérteni vmiben", "egy véleményen lenni"}, ""},
+					},
 				},
 				"verb",
 				"peteraba",
@@ -808,6 +878,9 @@ var verbCreationSuccessCases = []struct {
 				[]string{},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]Auxiliary{Sein},
 			Prefix{"", false},
@@ -855,11 +928,13 @@ var verbCreationSuccessCases = []struct {
 		&Verb{
 			DefaultWord{
 				"sein",
-				[]Meaning{
-					Meaning{"to be", ""},
-				},
-				[]Meaning{
-					Meaning{"létezni", ""},
+				map[string][]Meaning{
+					LangEnglish: []Meaning{
+						Meaning{[]string{"to be"}, ""},
+					},
+					LangHungarian: []Meaning{
+						Meaning{[]string{"létezni"}, ""},
+					},
 				},
 				"verb",
 				"peteraba",
@@ -868,6 +943,9 @@ var verbCreationSuccessCases = []struct {
 				[]string{},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]Auxiliary{Sein},
 			Prefix{"", false},
@@ -915,11 +993,13 @@ var verbCreationSuccessCases = []struct {
 		&Verb{
 			DefaultWord{
 				"ausgeben",
-				[]Meaning{
-					Meaning{"to pose as sb, to personate sb", ""},
-				},
-				[]Meaning{
-					Meaning{"kiadni magát vkinek", ""},
+				map[string][]Meaning{
+					LangEnglish: []Meaning{
+						Meaning{[]string{"to pose as sb", "to personate sb"}, ""},
+					},
+					LangHungarian: []Meaning{
+						Meaning{[]string{"kiadni magát vkinek"}, ""},
+					},
 				},
 				"verb",
 				"peteraba",
@@ -928,6 +1008,9 @@ var verbCreationSuccessCases = []struct {
 				[]string{},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]Auxiliary{Haben},
 			Prefix{"aus", true},
@@ -977,10 +1060,11 @@ var verbCreationSuccessCases = []struct {
 		&Verb{
 			DefaultWord{
 				"besinnen",
-				[]Meaning{
-					Meaning{"to think better of sth", ""},
+				map[string][]Meaning{
+					LangEnglish: []Meaning{
+						Meaning{[]string{"to think better of sth"}, ""},
+					},
 				},
-				[]Meaning{},
 				"verb",
 				"peteraba",
 				time.Date(2015, 5, 8, 0, 0, 0, 0, time.UTC),
@@ -988,6 +1072,9 @@ var verbCreationSuccessCases = []struct {
 				[]string{},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]Auxiliary{Haben},
 			Prefix{"be", false},
@@ -1037,10 +1124,11 @@ var verbCreationSuccessCases = []struct {
 		&Verb{
 			DefaultWord{
 				"besinnen",
-				[]Meaning{
-					Meaning{"to think better of sth", ""},
+				map[string][]Meaning{
+					LangEnglish: []Meaning{
+						Meaning{[]string{"to think better of sth"}, ""},
+					},
 				},
-				[]Meaning{},
 				"verb",
 				"peteraba",
 				time.Date(2015, 5, 8, 0, 0, 0, 0, time.UTC),
@@ -1048,6 +1136,9 @@ var verbCreationSuccessCases = []struct {
 				[]string{},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]Auxiliary{Haben},
 			Prefix{"be", false},
@@ -1097,10 +1188,11 @@ var verbCreationSuccessCases = []struct {
 		&Verb{
 			DefaultWord{
 				"besinnen",
-				[]Meaning{
-					Meaning{"to think better of sth", ""},
+				map[string][]Meaning{
+					LangEnglish: []Meaning{
+						Meaning{[]string{"to think better of sth"}, ""},
+					},
 				},
-				[]Meaning{},
 				"verb",
 				"peteraba",
 				time.Date(2015, 5, 8, 0, 0, 0, 0, time.UTC),
@@ -1108,6 +1200,9 @@ var verbCreationSuccessCases = []struct {
 				[]string{},
 				[]string{"Reflexive definition is invalid"},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			[]Auxiliary{Haben},
 			Prefix{"be", false},
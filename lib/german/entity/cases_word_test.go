@@ -30,18 +30,46 @@ var meaningCreationSuccessCases = []struct {
 	{
 		"to spank, to beat, to hit (colloquial)",
 		[]Meaning{
-			Meaning{"to spank, to beat, to hit", "colloquial"},
+			Meaning{[]string{"to spank", "to beat", "to hit"}, "colloquial"},
 		},
 	},
 	{
 		"beleolvasni (átv. is); sorok között olvasni",
 		[]Meaning{
-			Meaning{"beleolvasni", "átv. is"},
-			Meaning{"sorok között olvasni", ""},
+			Meaning{[]string{"beleolvasni"}, "átv. is"},
+			Meaning{[]string{"sorok között olvasni"}, ""},
 		},
 	},
 }
 
+var meaningSynonymCases = []struct {
+	meaning            Meaning
+	raw, main          string
+	accepted, rejected []string
+}{
+	{
+		Meaning{[]string{"expired", "out of date"}, ""},
+		"expired, out of date",
+		"expired",
+		[]string{"expired", "out of date", " Out of date "},
+		[]string{"expired, out of date", "date", ""},
+	},
+	{
+		Meaning{[]string{"to spank", "to beat", "to hit"}, "colloquial"},
+		"to spank, to beat, to hit (colloquial)",
+		"to spank",
+		[]string{"to beat", "to hit"},
+		[]string{"colloquial", "to hit (colloquial)"},
+	},
+	{
+		Meaning{[]string{}, ""},
+		"",
+		"",
+		[]string{},
+		[]string{""},
+	},
+}
+
 var meaningCreationFailureCases = []struct {
 	allMeanings string
 }{
@@ -53,6 +81,18 @@ var meaningCreationFailureCases = []struct {
 	},
 }
 
+var newLanguageCases = []struct {
+	raw, lang string
+	ok        bool
+}{
+	{"en", LangEnglish, true},
+	{" Hungarian ", LangHungarian, true},
+	{"ES", LangSpanish, true},
+	{"polski", LangPolish, true},
+	{"notes", "", false},
+	{"", "", false},
+}
+
 var wordCreationSuccessCases = []struct {
 	german, english, third, category, user, learned, score, tags string
 	errors                                                       []string
@@ -71,11 +111,13 @@ var wordCreationSuccessCases = []struct {
 		&Any{
 			DefaultWord{
 				"Ich versteh nur Bahnhof",
-				[]Meaning{
-					Meaning{"I understand just train-station", ""},
-				},
-				[]Meaning{
-					Meaning{"Én csak a vasútállomásokat értem", ""},
+				map[string][]Meaning{
+					LangEnglish: []Meaning{
+						Meaning{[]string{"I understand just train-station"}, ""},
+					},
+					LangHungarian: []Meaning{
+						Meaning{[]string{"Én csak a vasútállomásokat értem"}, ""},
+					},
 				},
 				"exp",
 				"peteraba",
@@ -84,6 +126,9 @@ var wordCreationSuccessCases = []struct {
 				[]string{"idiom", "ithinkispider.com"},
 				[]string{},
 				[]*general.Score{},
+				[]Example{},
+				"",
+				general.Schedule{},
 			},
 			"",
 		},
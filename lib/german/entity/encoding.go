@@ -0,0 +1,22 @@
+package entity
+
+// SchemaVersion is the version of the document layout written by the Marshal / GetBSON methods
+const SchemaVersion = 1
+
+// Word types used as type discriminator of encoded words
+const (
+	TypeAny       = "any"
+	TypeNoun      = "noun"
+	TypeVerb      = "verb"
+	TypeAdjective = "adj"
+)
+
+// Envelope is written next to the fields of every encoded word
+type Envelope struct {
+	Type   string `bson:"type" json:"type"`
+	Schema int    `bson:"schema" json:"schema"`
+}
+
+func NewEnvelope(wordType string) Envelope {
+	return Envelope{wordType, SchemaVersion}
+}
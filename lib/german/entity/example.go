@@ -0,0 +1,192 @@
+package entity
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/peteraba/d5/lib/util"
+)
+
+const (
+	exampleSeparator     = "|"
+	translationSeparator = "="
+	ClozeGap             = "___"
+)
+
+type Example struct {
+	German      string `bson:"german" json:"german"`
+	Translation string `bson:"translation" json:"translation,omitempty"`
+}
+
+// NewExamples parses examples like "Ich gehe nach Hause. = I go home. | Er geht. = He goes."
+func NewExamples(allExamples string) []Example {
+	examples := []Example{}
+
+	for _, rawExample := range util.TrimSplit(allExamples, exampleSeparator) {
+		parts := strings.SplitN(rawExample, translationSeparator, 2)
+
+		example := Example{German: strings.Trim(parts[0], defaultWhitespace)}
+		if len(parts) > 1 {
+			example.Translation = strings.Trim(parts[1], defaultWhitespace)
+		}
+
+		if example.German == "" {
+			continue
+		}
+
+		examples = append(examples, example)
+	}
+
+	return examples
+}
+
+type Cloze struct {
+	Sentence    string `bson:"sentence" json:"sentence"`
+	Translation string `bson:"translation" json:"translation,omitempty"`
+	Answer      string `bson:"answer" json:"answer"`
+}
+
+// GetClozes returns the examples of a word containing one of its forms, the form is replaced by a gap
+func GetClozes(word Word) []Cloze {
+	var (
+		clozes = []Cloze{}
+		forms  = GetForms(word)
+	)
+
+	for _, example := range word.GetExamples() {
+		if cloze, ok := NewCloze(example, forms); ok {
+			clozes = append(clozes, cloze)
+		}
+	}
+
+	return clozes
+}
+
+// NewCloze replaces the first whole word occurrence of the longest matching form with a gap
+func NewCloze(example Example, forms []string) (Cloze, bool) {
+	sorted := append([]string{}, forms...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return len(sorted[i]) > len(sorted[j])
+	})
+
+	for _, form := range sorted {
+		if form == "" {
+			continue
+		}
+
+		idx := findWord(example.German, form)
+		if idx < 0 {
+			continue
+		}
+
+		sentence := example.German[:idx] + ClozeGap + example.German[idx+len(form):]
+
+		return Cloze{sentence, example.Translation, example.German[idx : idx+len(form)]}, true
+	}
+
+	return Cloze{}, false
+}
+
+// GetForms lists the known inflected forms of a word, starting with its dictionary form
+func GetForms(word Word) []string {
+	forms := []string{word.GetGerman()}
+
+	switch w := word.(type) {
+	case *Noun:
+		forms = append(forms, w.GetPlurals()...)
+		forms = append(forms, w.GetGenitives()...)
+		if len(w.Articles) > 0 {
+			for _, nounCase := range Cases {
+				forms = append(forms, w.Decline(false, nounCase)...)
+				forms = append(forms, w.Decline(true, nounCase)...)
+			}
+		}
+	case *Verb:
+		for _, pp := range []PersonalPronoun{S1, S2, S3, P1, P2, P3} {
+			forms = append(forms, w.GetVerbPresent(pp)...)
+			forms = append(forms, w.GetVerbPreterite(pp)...)
+		}
+		forms = append(forms, w.PastParticiple...)
+	case *Adjective:
+		forms = append(forms, w.GetComparative()...)
+		forms = append(forms, w.GetSuperlative()...)
+		forms = append(forms, getDeclinedForms(w)...)
+	}
+
+	return uniqueForms(forms)
+}
+
+// getDeclinedForms returns the attributive forms of an adjective in every degree, declension, gender, number and case
+func getDeclinedForms(adjective *Adjective) []string {
+	forms := []string{}
+
+	for _, degree := range []Degree{Positive, Comparative, Superlative} {
+		for _, declension := range []Declension{Strong, Weak, Mixed} {
+			for _, nounArticle := range []Article{Der, Die, Das} {
+				for _, nounCase := range Cases {
+					forms = append(forms, adjective.Decline(degree, declension, nounArticle, false, nounCase)...)
+					forms = append(forms, adjective.Decline(degree, declension, nounArticle, true, nounCase)...)
+				}
+			}
+		}
+	}
+
+	return forms
+}
+
+func uniqueForms(forms []string) []string {
+	result := []string{}
+
+	for _, form := range forms {
+		if form != "" && form != "-" && !util.StringIn(form, result) {
+			result = append(result, form)
+		}
+	}
+
+	return result
+}
+
+// findWord finds a form in a sentence as a whole word, the first letter of the form is case insensitive
+func findWord(sentence, form string) int {
+	first, size := utf8.DecodeRuneInString(form)
+
+	for _, variant := range []string{form, string(unicode.ToUpper(first)) + form[size:], string(unicode.ToLower(first)) + form[size:]} {
+		offset := 0
+
+		for {
+			idx := strings.Index(sentence[offset:], variant)
+			if idx < 0 {
+				break
+			}
+
+			idx += offset
+			if isWordBoundary(sentence, idx, idx+len(variant)) {
+				return idx
+			}
+
+			offset = idx + len(variant)
+		}
+	}
+
+	return -1
+}
+
+func isWordBoundary(sentence string, start, end int) bool {
+	if start > 0 {
+		before, _ := utf8.DecodeLastRuneInString(sentence[:start])
+		if unicode.IsLetter(before) {
+			return false
+		}
+	}
+
+	if end < len(sentence) {
+		after, _ := utf8.DecodeRuneInString(sentence[end:])
+		if unicode.IsLetter(after) {
+			return false
+		}
+	}
+
+	return true
+}
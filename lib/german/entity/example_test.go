@@ -0,0 +1,36 @@
+package entity
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewExamples(t *testing.T) {
+	for num, testCase := range newExamplesCases {
+		examples := NewExamples(testCase.raw)
+
+		if !reflect.DeepEqual(examples, testCase.examples) {
+			t.Fatalf("Case #%d: Expected: %v, got: %v", num+1, testCase.examples, examples)
+		}
+	}
+
+	t.Log(len(newExamplesCases), "test cases")
+}
+
+func TestGetClozes(t *testing.T) {
+	for num, testCase := range clozeCases {
+		if testCase.word == nil {
+			t.Fatalf("Case #%d: Word could not be created.", num+1)
+		}
+
+		testCase.word.SetExamples(testCase.examples)
+
+		clozes := GetClozes(testCase.word)
+
+		if !reflect.DeepEqual(clozes, testCase.cloze) {
+			t.Fatalf("Case #%d: Expected: %v, got: %v", num+1, testCase.cloze, clozes)
+		}
+	}
+
+	t.Log(len(clozeCases), "test cases")
+}
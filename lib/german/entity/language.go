@@ -0,0 +1,48 @@
+package entity
+
+import "strings"
+
+const (
+	LangEnglish   = "en"
+	LangGerman    = "de"
+	LangHungarian = "hu"
+	LangSpanish   = "es"
+	LangPolish    = "pl"
+	LangFrench    = "fr"
+	LangItalian   = "it"
+)
+
+const DefaultLanguage = LangEnglish
+
+var languageNames = map[string]string{
+	"english":   LangEnglish,
+	"german":    LangGerman,
+	"deutsch":   LangGerman,
+	"hungarian": LangHungarian,
+	"magyar":    LangHungarian,
+	"spanish":   LangSpanish,
+	"español":   LangSpanish,
+	"polish":    LangPolish,
+	"polski":    LangPolish,
+	"french":    LangFrench,
+	"français":  LangFrench,
+	"italian":   LangItalian,
+	"italiano":  LangItalian,
+}
+
+// NewLanguage turns a language code or a language name (as used in spreadsheet headers) into a language code
+func NewLanguage(raw string) (string, bool) {
+	raw = strings.ToLower(strings.Trim(raw, defaultWhitespace))
+
+	if lang, ok := languageNames[raw]; ok {
+		return lang, true
+	}
+
+	for _, lang := range languageNames {
+		if lang == raw {
+			return lang, true
+		}
+	}
+
+	return "", false
+}
@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"encoding/json"
 	"regexp"
 	"strings"
 
@@ -37,7 +38,7 @@ type Noun struct {
 	Id           bson.ObjectId `bson:"_id,omitempty" json:"_id,omitempty"`
 }
 
-func NewNoun(articles, german, english, third, user, learned, score, tags string) *Noun {
+func NewNoun(articles, german string, meanings map[string]string, user, learned, score, tags string) *Noun {
 	matches := NounRegexp.FindStringSubmatch(german)
 
 	if len(matches) < 5 {
@@ -64,7 +65,7 @@ func NewNoun(articles, german, english, third, user, learned, score, tags string
 	german = matches[1]
 
 	return &Noun{
-		NewDefaultWord(german, english, third, "noun", user, learned, score, tags, errors),
+		NewDefaultWord(german, meanings, CategoryNoun, user, learned, score, tags, errors),
 		articleList,
 		util.TrimSplit(matches[2], alternativeSeparator),
 		util.TrimSplit(matches[4], alternativeSeparator),
@@ -85,6 +86,27 @@ func (n *Noun) GetScores() []*general.Score {
 	return n.Scores
 }
 
+func (n *Noun) GetType() string {
+	return TypeNoun
+}
+
+func (n Noun) encode() interface{} {
+	type noun Noun
+
+	return struct {
+		Envelope `bson:",inline"`
+		noun     `bson:",inline"`
+	}{NewEnvelope(TypeNoun), noun(n)}
+}
+
+func (n Noun) MarshalJSON() ([]byte, error) {
+	return json.Marshal(n.encode())
+}
+
+func (n Noun) GetBSON() (interface{}, error) {
+	return n.encode(), nil
+}
+
 func (n *Noun) GetPlurals() []string {
 	if n.IsPluralOnly {
 		return []string{n.German}
@@ -216,6 +238,32 @@ func (n *Noun) Decline(
 	return result
 }
 
+// DeclineWithArticle returns the declined noun following a determiner, e.g. "der", "ein" or "kein", for each of its articles
+func (n *Noun) DeclineWithArticle(determiner string, isDefinite, isPlural bool, nounCase Case) []string {
+	var (
+		result = []string{}
+		forms  = n.Decline(isPlural, nounCase)
+		word   string
+	)
+
+	for _, article := range n.Articles {
+		if isDefinite {
+			word = DefiniteArticle(determiner, article, isPlural, nounCase)
+		} else {
+			word = IndefiniteArticle(determiner, article, isPlural, nounCase)
+		}
+
+		for _, form := range forms {
+			phrase := strings.Trim(word+" "+form, " ")
+			if !util.StringIn(phrase, result) {
+				result = append(result, phrase)
+			}
+		}
+	}
+
+	return result
+}
+
 var mixedNouns = []string{"Herz", "Buchstabe", "Gedanke", "Friede", "Glaube", "Wille"}
 
 func (n *Noun) IsMixed() bool {
@@ -40,8 +40,7 @@ func TestNounCreationSuccess(t *testing.T) {
 		noun := NewNoun(
 			testCase.articles,
 			testCase.german,
-			testCase.english,
-			testCase.third,
+			map[string]string{LangEnglish: testCase.english, LangHungarian: testCase.third},
 			testCase.user,
 			testCase.learned,
 			testCase.score,
@@ -65,7 +64,7 @@ func TestNounCreationSuccess(t *testing.T) {
 }
 
 func TestNounCreationFailure(t *testing.T) {
-	var actual = NewNoun("", "", "", "", "", "", "", "")
+	var actual = NewNoun("", "", map[string]string{}, "", "", "", "")
 
 	if actual != nil {
 		t.Fatal("Noun should have not been created with empty German argument")
@@ -291,3 +290,16 @@ func TestPluralGenitiveDeclension(t *testing.T) {
 
 	t.Log(len(getNounDeclensionCases), "test cases")
 }
+
+func TestDeclineWithArticle(t *testing.T) {
+	for num, testCase := range declineWithArticleCases {
+		expected := strings.Join(testCase.expected, ",")
+		actual := strings.Join(testCase.noun.DeclineWithArticle(testCase.determiner, testCase.isDefinite, testCase.isPlural, testCase.nounCase), ",")
+
+		if expected != actual {
+			t.Fatalf("Declension with article of test case #%d is not as expected. Expected: '%s', got: '%s'.", num+1, expected, actual)
+		}
+	}
+
+	t.Log(len(declineWithArticleCases), "test cases")
+}
@@ -0,0 +1,125 @@
+package entity
+
+import (
+	"strings"
+
+	"github.com/peteraba/d5/lib/german/dict"
+)
+
+// PluralRule is a standard pattern of forming the plural of nouns
+type PluralRule struct {
+	Description string
+	articles    []Article
+	suffixes    []string
+	extension   string
+}
+
+var pluralRules = []PluralRule{
+	PluralRule{
+		"Feminine nouns ending in -in double the n and add -en (e.g. die Lehrerin, die Lehrerinnen).",
+		[]Article{Die},
+		[]string{"in"},
+		"~nen",
+	},
+	PluralRule{
+		"Feminine nouns ending in -ung, -heit, -keit, -schaft, -ion, -tät, -ei, -ik and -ur add -en (e.g. die Zeitung, die Zeitungen).",
+		[]Article{Die},
+		[]string{"ung", "heit", "keit", "schaft", "ion", "tät", "ei", "ik", "ur"},
+		"~en",
+	},
+	PluralRule{
+		"Feminine nouns ending in -e, -el and -er add -n (e.g. die Lampe, die Lampen).",
+		[]Article{Die},
+		[]string{"e", "el", "er"},
+		"~n",
+	},
+	PluralRule{
+		"Masculine nouns ending in -e are n-nouns and add -n (e.g. der Junge, die Jungen).",
+		[]Article{Der},
+		[]string{"e"},
+		"~n",
+	},
+	PluralRule{
+		"Diminutives ending in -chen and -lein don't change (e.g. das Mädchen, die Mädchen).",
+		[]Article{Das},
+		[]string{"chen", "lein"},
+		"~",
+	},
+	PluralRule{
+		"Masculine and neuter nouns ending in -er, -el and -en get no ending, sometimes an umlaut (e.g. der Lehrer, die Lehrer; der Vater, die Väter).",
+		[]Article{Der, Das},
+		[]string{"er", "el", "en"},
+		"~",
+	},
+	PluralRule{
+		"Nouns ending in -nis add -se (e.g. das Ergebnis, die Ergebnisse).",
+		[]Article{Der, Die, Das},
+		[]string{"nis"},
+		"~se",
+	},
+	PluralRule{
+		"Nouns ending in -a, -i, -o, -u and -y, mostly of foreign origin, add -s (e.g. das Auto, die Autos).",
+		[]Article{Der, Die, Das},
+		[]string{"a", "i", "o", "u", "y"},
+		"~s",
+	},
+}
+
+// GetPluralRule returns the standard pattern the plural of a noun follows
+func GetPluralRule(noun *Noun) (PluralRule, bool) {
+	if noun.IsPluralOnly || len(noun.Articles) == 0 {
+		return PluralRule{}, false
+	}
+
+	plurals := noun.GetPlurals()
+
+	for _, rule := range pluralRules {
+		if !rule.applies(noun) {
+			continue
+		}
+
+		for _, plural := range rule.getPlurals(noun.German) {
+			for _, actual := range plurals {
+				if actual == plural {
+					return rule, true
+				}
+			}
+		}
+
+		return PluralRule{}, false
+	}
+
+	return PluralRule{}, false
+}
+
+func (r PluralRule) applies(noun *Noun) bool {
+	hasArticle := false
+	for _, article := range r.articles {
+		if article == noun.Articles[0] {
+			hasArticle = true
+		}
+	}
+
+	if !hasArticle {
+		return false
+	}
+
+	for _, suffix := range r.suffixes {
+		if strings.HasSuffix(noun.German, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getPlurals returns the plural formed by the rule, rules without ending may also add an umlaut
+func (r PluralRule) getPlurals(german string) []string {
+	plurals := []string{dict.Decline(german, r.extension)}
+
+	if r.extension == "~" {
+		plurals = append(plurals, dict.Decline(german, "⍨"))
+	}
+
+	return plurals
+}
@@ -0,0 +1,46 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+)
+
+var getPluralRuleCases = []struct {
+	articles, german string
+	found            bool
+	prefix           string
+}{
+	{"e", "Lehrerin,~nen", true, "Feminine nouns ending in -in"},
+	{"e", "Zeitung,~en", true, "Feminine nouns ending in -ung"},
+	{"e", "Lampe,~n", true, "Feminine nouns ending in -e,"},
+	{"r", "Junge,~n", true, "Masculine nouns ending in -e"},
+	{"s", "Mädchen,~", true, "Diminutives"},
+	{"r", "Lehrer,~", true, "Masculine and neuter"},
+	{"r", "Vater,⍨", true, "Masculine and neuter"},
+	{"s", "Ergebnis,~se", true, "Nouns ending in -nis"},
+	{"s", "Auto,~s", true, "Nouns ending in -a"},
+	{"e", "Mutter,⍨", false, ""},
+	{"r", "Tisch,~e", false, ""},
+	{"e", "Leute,(pl)", false, ""},
+}
+
+func TestGetPluralRule(t *testing.T) {
+	for num, testCase := range getPluralRuleCases {
+		noun := NewNoun(testCase.articles, testCase.german, map[string]string{}, "", "", "", "")
+		if noun == nil {
+			t.Fatalf("Case #%d: Noun could not be created.", num+1)
+		}
+
+		rule, found := GetPluralRule(noun)
+
+		if found != testCase.found {
+			t.Fatalf("Case #%d: Expected found to be %v, got %v.", num+1, testCase.found, found)
+		}
+
+		if !strings.HasPrefix(rule.Description, testCase.prefix) {
+			t.Fatalf("Case #%d: Expected rule starting with '%s', got: '%s'.", num+1, testCase.prefix, rule.Description)
+		}
+	}
+
+	t.Log(len(getPluralRuleCases), "test cases")
+}
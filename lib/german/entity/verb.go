@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"encoding/json"
 	"regexp"
 	"strings"
 
@@ -27,6 +28,20 @@ const (
 	CaseGenitive        = "G"
 )
 
+var Cases = []Case{CaseNominative, CaseAcusative, CaseDative, CaseGenitive}
+
+var caseNames = map[Case]string{
+	CaseNominative: "nominative",
+	CaseAcusative:  "accusative",
+	CaseDative:     "dative",
+	CaseGenitive:   "genitive",
+}
+
+// GetCaseName returns the English name of a case
+func GetCaseName(nounCase Case) string {
+	return caseNames[nounCase]
+}
+
 type Auxiliary string
 
 const (
@@ -91,6 +106,7 @@ type Argument struct {
 	Case        Case   `bson:"case" json:"case,omitempty"`
 }
 
+// NewArgument parses an argument of a verb, the case is left empty if none is noted
 func NewArgument(word string) Argument {
 	matches := ArgumentRegexp.FindStringSubmatch(word)
 
@@ -104,9 +120,11 @@ func NewArgument(word string) Argument {
 		return Argument{p, CaseNominative}
 	case "D":
 		return Argument{p, CaseDative}
+	case "G":
+		return Argument{p, CaseGenitive}
 	}
 
-	return Argument{p, CaseGenitive}
+	return Argument{p, ""}
 }
 
 func NewArguments(allArguments string) []Argument {
@@ -350,7 +368,7 @@ func NewVerbP1(german string) string {
 	return words[len(words)-1]
 }
 
-func NewVerb(auxiliary, german, english, third, user, learned, score, tags string) *Verb {
+func NewVerb(auxiliary, german string, meanings map[string]string, user, learned, score, tags string) *Verb {
 	pastParticiple, preterite, s1, s2, s3, p1, p2, p3 := "", "", "", "", "", "", "", ""
 
 	matches := VerbRegexp.FindStringSubmatch(german)
@@ -391,7 +409,7 @@ func NewVerb(auxiliary, german, english, third, user, learned, score, tags strin
 	}
 
 	return &Verb{
-		NewDefaultWord(german, english, third, "verb", user, learned, score, tags, errors),
+		NewDefaultWord(german, meanings, CategoryVerb, user, learned, score, tags, errors),
 		NewAuxiliary(util.TrimSplit(auxiliary, alternativeSeparator)),
 		prefix,
 		noun,
@@ -422,6 +440,27 @@ func (v *Verb) GetScores() []*general.Score {
 	return v.Scores
 }
 
+func (v *Verb) GetType() string {
+	return TypeVerb
+}
+
+func (v Verb) encode() interface{} {
+	type verb Verb
+
+	return struct {
+		Envelope `bson:",inline"`
+		verb     `bson:",inline"`
+	}{NewEnvelope(TypeVerb), verb(v)}
+}
+
+func (v Verb) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.encode())
+}
+
+func (v Verb) GetBSON() (interface{}, error) {
+	return v.encode(), nil
+}
+
 func (v *Verb) getPresentStem() []string {
 	p1s := []string{}
 
@@ -609,7 +648,7 @@ func (v *Verb) GetSeparated(pp PersonalPronoun, tense Tense) [][2]string {
 	for _, word := range nonSeparated {
 		resultItem = [2]string{word, ""}
 		if v.Prefix.Separable && v.Prefix.Prefix != "" && tense != PastParticiple {
-			resultItem[0] = strings.TrimLeft(word, v.Prefix.Prefix)
+			resultItem[0] = strings.TrimPrefix(word, v.Prefix.Prefix)
 			resultItem[1] = v.Prefix.Prefix
 		}
 
@@ -144,8 +144,7 @@ func TestVerbCreationSuccess(t *testing.T) {
 		verb := NewVerb(
 			testCase.auxiliary,
 			testCase.german,
-			testCase.english,
-			testCase.third,
+			map[string]string{LangEnglish: testCase.english, LangHungarian: testCase.third},
 			testCase.user,
 			testCase.learned,
 			testCase.score,
@@ -173,8 +172,7 @@ func TestVerbCreationFailure(t *testing.T) {
 		verb := NewVerb(
 			testCase.auxiliary,
 			testCase.german,
-			testCase.english,
-			testCase.third,
+			map[string]string{LangEnglish: testCase.english, LangHungarian: testCase.third},
 			testCase.user,
 			testCase.learned,
 			testCase.score,
@@ -264,3 +262,27 @@ func conjugationSeparatedCheck(t *testing.T, german string, expected [][2]string
 		)
 	}
 }
+
+var separatedPrefixCases = []struct {
+	german   string
+	pp       PersonalPronoun
+	tense    Tense
+	expected [][2]string
+}{
+	{"ab|bauen", S1, Present, [][2]string{[2]string{"baue", "ab"}}},
+	{"an|rufen", P2, Present, [][2]string{[2]string{"ruft", "an"}}},
+	{"auf|hören", S3, Preterite, [][2]string{[2]string{"hörte", "auf"}}},
+	{"an|rufen", S1, PastParticiple, [][2]string{}},
+}
+
+func TestGetSeparatedKeepsStem(t *testing.T) {
+	for _, testCase := range separatedPrefixCases {
+		verb := NewVerb("h", testCase.german, map[string]string{}, "", "", "", "")
+
+		actual := verb.GetSeparated(testCase.pp, testCase.tense)
+
+		conjugationSeparatedCheck(t, verb.German, testCase.expected, actual)
+	}
+
+	t.Log(len(separatedPrefixCases), "test cases")
+}
@@ -1,7 +1,9 @@
 package entity
 
 import (
+	"encoding/json"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -13,6 +15,12 @@ import (
 
 const learnedForm = "2006-01-02"
 
+const (
+	CategoryNoun      = "noun"
+	CategoryVerb      = "verb"
+	CategoryAdjective = "adj"
+)
+
 const (
 	alternativeSeparator = "/"
 	conjugationSeparator = ","
@@ -53,21 +61,64 @@ type Word interface {
 	GetId() bson.ObjectId
 	SetId(bson.ObjectId)
 	GetGerman() string
-	GetEnglish() []Meaning
-	GetThird() []Meaning
+	GetMeanings(lang string) []Meaning
+	GetLanguages() []string
 	GetCategory() string
+	GetType() string
 	GetUser() string
 	GetScore() int
 	GetLearned() time.Time
 	GetErrors() []string
+	GetExamples() []Example
+	SetExamples(examples []Example)
+	GetNotes() string
+	SetNotes(notes string)
 	GetScores() []*general.Score
 	AddScore(score *general.Score)
 	NewScore(result int)
+	GetSchedule() general.Schedule
+	SetSchedule(schedule general.Schedule)
 }
 
 type Meaning struct {
-	Main        string `bson:"main" json:"main,omitempty"`
-	Parantheses string `bson:"parantheses" json:"parantheses,omitempty"`
+	Synonyms    []string `bson:"synonyms" json:"synonyms,omitempty"`
+	Parantheses string   `bson:"parantheses" json:"parantheses,omitempty"`
+}
+
+func NewMeaning(synonyms, parantheses string) Meaning {
+	return Meaning{util.TrimSplit(synonyms, synonimSeparator), parantheses}
+}
+
+// GetMain returns the first, preferred synonym of the meaning
+func (m Meaning) GetMain() string {
+	if len(m.Synonyms) == 0 {
+		return ""
+	}
+
+	return m.Synonyms[0]
+}
+
+// HasSynonym checks if any of the synonyms matches the given translation
+func (m Meaning) HasSynonym(translation string) bool {
+	translation = strings.Trim(translation, defaultWhitespace)
+
+	for _, synonym := range m.Synonyms {
+		if strings.EqualFold(synonym, translation) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m Meaning) String() string {
+	result := strings.Join(m.Synonyms, synonimSeparator+wordSeparator)
+
+	if m.Parantheses == "" {
+		return result
+	}
+
+	return result + " (" + m.Parantheses + ")"
 }
 
 func NewMeanings(allMeanings string, errors []string) ([]Meaning, []string) {
@@ -84,28 +135,56 @@ func NewMeanings(allMeanings string, errors []string) ([]Meaning, []string) {
 		m := strings.Trim(matches[1], defaultWhitespace)
 		p := strings.Trim(matches[3], defaultWhitespace)
 
-		meanings = append(meanings, Meaning{m, p})
+		meanings = append(meanings, NewMeaning(m, p))
 	}
 
 	return meanings, errors
 }
 
+// NewTranslations parses the meanings of each language, languages without meanings are skipped
+func NewTranslations(allTranslations map[string]string, errors []string) (map[string][]Meaning, []string) {
+	var (
+		translations = map[string][]Meaning{}
+		meanings     []Meaning
+		languages    = []string{}
+	)
+
+	for lang := range allTranslations {
+		languages = append(languages, lang)
+	}
+
+	sort.Strings(languages)
+
+	for _, lang := range languages {
+		meanings, errors = NewMeanings(allTranslations[lang], errors)
+
+		if len(meanings) == 0 {
+			continue
+		}
+
+		translations[lang] = meanings
+	}
+
+	return translations, errors
+}
+
 type DefaultWord struct {
-	German   string           `bson:"german" json:"german,omitempty"`
-	English  []Meaning        `bson:"english" json:"english,omitempty"`
-	Third    []Meaning        `bson:"third" json:"third,omitempty"`
-	Category string           `bson:"category" json:"category,omitempty"`
-	User     string           `bson:"user" json:"user,omitempty"`
-	Learned  time.Time        `bson:"learned" json:"learned,omitempty"`
-	Score    int              `bson:"score" json:"score,omitempty"`
-	Tags     []string         `bson:"tags" json:"tags,omitempty"`
-	Errors   []string         `bson:"errors" json:"errors,omitempty"`
-	Scores   []*general.Score `bson:"scores" json:"scores,omitempty"`
-}
-
-func NewDefaultWord(german, english, third, category, user, learned, score, tags string, errors []string) DefaultWord {
-	englishMeanings, errors := NewMeanings(english, errors)
-	thirdMeanings, errors := NewMeanings(third, errors)
+	German   string               `bson:"german" json:"german,omitempty"`
+	Meanings map[string][]Meaning `bson:"meanings" json:"meanings,omitempty"`
+	Category string               `bson:"category" json:"category,omitempty"`
+	User     string               `bson:"user" json:"user,omitempty"`
+	Learned  time.Time            `bson:"learned" json:"learned,omitempty"`
+	Score    int                  `bson:"score" json:"score,omitempty"`
+	Tags     []string             `bson:"tags" json:"tags,omitempty"`
+	Errors   []string             `bson:"errors" json:"errors,omitempty"`
+	Scores   []*general.Score     `bson:"scores" json:"scores,omitempty"`
+	Examples []Example            `bson:"examples" json:"examples,omitempty"`
+	Notes    string               `bson:"notes" json:"notes,omitempty"`
+	Schedule general.Schedule     `bson:"schedule" json:"schedule,omitempty"`
+}
+
+func NewDefaultWord(german string, meanings map[string]string, category, user, learned, score, tags string, errors []string) DefaultWord {
+	translations, errors := NewTranslations(meanings, errors)
 
 	scoreParsed, err := strconv.ParseInt(score, 0, 0)
 	if err != nil || scoreParsed < 1 || scoreParsed > 10 {
@@ -116,8 +195,7 @@ func NewDefaultWord(german, english, third, category, user, learned, score, tags
 
 	return DefaultWord{
 		german,
-		englishMeanings,
-		thirdMeanings,
+		translations,
 		category,
 		user,
 		learnedParsed,
@@ -125,6 +203,9 @@ func NewDefaultWord(german, english, third, category, user, learned, score, tags
 		util.TrimSplit(tags, tagSeparator),
 		errors,
 		[]*general.Score{},
+		[]Example{},
+		"",
+		general.Schedule{},
 	}
 }
 
@@ -132,18 +213,35 @@ func (w *DefaultWord) GetGerman() string {
 	return w.German
 }
 
-func (w *DefaultWord) GetEnglish() []Meaning {
-	return w.English
+// GetMeanings returns the meanings in the given language, falling back to English if there are none
+func (w *DefaultWord) GetMeanings(lang string) []Meaning {
+	if meanings, ok := w.Meanings[lang]; ok && len(meanings) > 0 {
+		return meanings
+	}
+
+	return w.Meanings[DefaultLanguage]
 }
 
-func (w *DefaultWord) GetThird() []Meaning {
-	return w.Third
+func (w *DefaultWord) GetLanguages() []string {
+	languages := []string{}
+
+	for lang := range w.Meanings {
+		languages = append(languages, lang)
+	}
+
+	sort.Strings(languages)
+
+	return languages
 }
 
 func (w *DefaultWord) GetCategory() string {
 	return w.Category
 }
 
+func (w *DefaultWord) GetType() string {
+	return TypeAny
+}
+
 func (w *DefaultWord) GetScore() int {
 	return w.Score
 }
@@ -160,6 +258,22 @@ func (w *DefaultWord) GetErrors() []string {
 	return w.Errors
 }
 
+func (w *DefaultWord) GetExamples() []Example {
+	return w.Examples
+}
+
+func (w *DefaultWord) SetExamples(examples []Example) {
+	w.Examples = examples
+}
+
+func (w *DefaultWord) GetNotes() string {
+	return w.Notes
+}
+
+func (w *DefaultWord) SetNotes(notes string) {
+	w.Notes = notes
+}
+
 func (w *DefaultWord) AddScore(score *general.Score) {
 	w.Scores = append(w.Scores, score)
 }
@@ -177,6 +291,14 @@ func (w *DefaultWord) NewScore(result int) {
 	w.Scores = append(w.Scores, score)
 }
 
+func (w *DefaultWord) GetSchedule() general.Schedule {
+	return w.Schedule
+}
+
+func (w *DefaultWord) SetSchedule(schedule general.Schedule) {
+	w.Schedule = schedule
+}
+
 func (w *DefaultWord) GetId() bson.ObjectId {
 	return ""
 }
@@ -189,8 +311,8 @@ type Any struct {
 	Id          bson.ObjectId `bson:"_id,omitempty" json:"_id,omitempty"`
 }
 
-func NewAny(german, english, third, category, user, learned, score, tags string, errors []string) *Any {
-	d := NewDefaultWord(german, english, third, category, user, learned, score, tags, errors)
+func NewAny(german string, meanings map[string]string, category, user, learned, score, tags string, errors []string) *Any {
+	d := NewDefaultWord(german, meanings, category, user, learned, score, tags, errors)
 
 	return &Any{d, ""}
 }
@@ -206,3 +328,24 @@ func (a *Any) SetId(id bson.ObjectId) {
 func (a *Any) GetScores() []*general.Score {
 	return a.Scores
 }
+
+func (a *Any) GetType() string {
+	return TypeAny
+}
+
+func (a Any) encode() interface{} {
+	type anyWord Any
+
+	return struct {
+		Envelope `bson:",inline"`
+		anyWord  `bson:",inline"`
+	}{NewEnvelope(TypeAny), anyWord(a)}
+}
+
+func (a Any) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.encode())
+}
+
+func (a Any) GetBSON() (interface{}, error) {
+	return a.encode(), nil
+}
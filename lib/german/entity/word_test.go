@@ -78,12 +78,61 @@ func TestMeaningCreationFailure(t *testing.T) {
 	t.Log(len(meaningCreationFailureCases), "test cases")
 }
 
+func TestMeaningGetMain(t *testing.T) {
+	for num, testCase := range meaningSynonymCases {
+		actual := testCase.meaning.GetMain()
+		if actual != testCase.main {
+			t.Fatalf(
+				"GetMain test failed for case #%d. Expected: '%s', got: '%s'.",
+				num+1,
+				testCase.main,
+				actual,
+			)
+		}
+	}
+
+	t.Log(len(meaningSynonymCases), "test cases")
+}
+
+func TestMeaningHasSynonym(t *testing.T) {
+	for num, testCase := range meaningSynonymCases {
+		for _, synonym := range testCase.accepted {
+			if !testCase.meaning.HasSynonym(synonym) {
+				t.Fatalf("HasSynonym test failed for case #%d. Expected '%s' to be accepted.", num+1, synonym)
+			}
+		}
+
+		for _, synonym := range testCase.rejected {
+			if testCase.meaning.HasSynonym(synonym) {
+				t.Fatalf("HasSynonym test failed for case #%d. Expected '%s' to be rejected.", num+1, synonym)
+			}
+		}
+	}
+
+	t.Log(len(meaningSynonymCases), "test cases")
+}
+
+func TestMeaningString(t *testing.T) {
+	for num, testCase := range meaningSynonymCases {
+		actual := testCase.meaning.String()
+		if actual != testCase.raw {
+			t.Fatalf(
+				"String test failed for case #%d. Expected: '%s', got: '%s'.",
+				num+1,
+				testCase.raw,
+				actual,
+			)
+		}
+	}
+
+	t.Log(len(meaningSynonymCases), "test cases")
+}
+
 func TestWordCreationSuccess(t *testing.T) {
 	for num, testCase := range wordCreationSuccessCases {
 		word := NewAny(
 			testCase.german,
-			testCase.english,
-			testCase.third,
+			map[string]string{LangEnglish: testCase.english, LangHungarian: testCase.third},
 			testCase.category,
 			testCase.user,
 			testCase.learned,
@@ -108,40 +157,66 @@ func TestWordCreationSuccess(t *testing.T) {
 	t.Log(len(wordCreationSuccessCases), "test cases")
 }
 
-func TestWordGetEnglish(t *testing.T) {
+func TestWordGetMeanings(t *testing.T) {
 	var word *Any
 
 	for num, testCase := range wordCreationSuccessCases {
 		word = testCase.word
 
-		actual := word.GetEnglish()
-		if !reflect.DeepEqual(actual, word.English) {
-			t.Fatal(
-				"GetEnglish test failed for case #%d. Expected: '%v', got: '%v'.",
+		for _, lang := range []string{LangEnglish, LangHungarian} {
+			actual := word.GetMeanings(lang)
+			if !reflect.DeepEqual(actual, word.Meanings[lang]) {
+				t.Fatalf(
+					"GetMeanings test failed for case #%d, language %s. Expected: '%v', got: '%v'.",
+					num+1,
+					lang,
+					word.Meanings[lang],
+					actual,
+				)
+			}
+		}
+
+		actual := word.GetMeanings(LangPolish)
+		if !reflect.DeepEqual(actual, word.Meanings[LangEnglish]) {
+			t.Fatalf(
+				"GetMeanings fallback test failed for case #%d. Expected: '%v', got: '%v'.",
 				num+1,
-				word.English,
+				word.Meanings[LangEnglish],
 				actual,
 			)
 		}
 	}
 }
 
-func TestWordGetThird(t *testing.T) {
+func TestWordGetLanguages(t *testing.T) {
 	var word *Any
 
 	for num, testCase := range wordCreationSuccessCases {
 		word = testCase.word
 
-		actual := word.GetThird()
-		if !reflect.DeepEqual(actual, word.Third) {
-			t.Fatal(
-				"GetThird test failed for case #%d. Expected: '%v', got: '%v'.",
+		actual := word.GetLanguages()
+		if !reflect.DeepEqual(actual, []string{LangEnglish, LangHungarian}) {
+			t.Fatalf("GetLanguages test failed for case #%d. Got: '%v'.", num+1, actual)
+		}
+	}
+}
+
+func TestNewLanguage(t *testing.T) {
+	for num, testCase := range newLanguageCases {
+		lang, ok := NewLanguage(testCase.raw)
+		if lang != testCase.lang || ok != testCase.ok {
+			t.Fatalf(
+				"NewLanguage test failed for case #%d. Expected: '%s', %v, got: '%s', %v.",
 				num+1,
-				word.Third,
-				actual,
+				testCase.lang,
+				testCase.ok,
+				lang,
+				ok,
 			)
 		}
 	}
+
+	t.Log(len(newLanguageCases), "test cases")
 }
 
 func TestWordGetCategory(t *testing.T) {
@@ -0,0 +1,255 @@
+package grade
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/peteraba/d5/lib/util"
+)
+
+// Scores given on the scorer's -10..10 scale
+const (
+	ScoreRight          = 10
+	ScoreCapitalization = 8
+	ScoreTypo           = 6
+	ScoreTwoTypos       = 3
+	ScoreWrong          = 0
+	ArticlePenalty      = 5
+)
+
+const (
+	// minTypoLength is the minimum length of words where a single typo is tolerated
+	minTypoLength = 4
+	// minTwoTyposLength is the minimum length of words where two typos are tolerated
+	minTwoTyposLength = 8
+)
+
+var (
+	articles = []string{"der", "die", "das"}
+
+	// determiners are the articles and pronouns inflected for case, number and gender
+	determiners = []string{
+		"der", "die", "das", "den", "dem", "des",
+		"ein", "eine", "einen", "einem", "einer", "eines",
+		"kein", "keine", "keinen", "keinem", "keiner", "keines",
+	}
+
+	// endings are the inflectional endings, words only differing in these are different forms, not typos
+	endings = []string{"", "e", "m", "n", "r", "s", "t", "en", "em", "er", "es", "et", "st", "ern", "ens", "est"}
+
+	transliterations = strings.NewReplacer(
+		"ä", "ae",
+		"ö", "oe",
+		"ü", "ue",
+		"Ä", "Ae",
+		"Ö", "Oe",
+		"Ü", "Ue",
+		"ß", "ss",
+	)
+)
+
+// grading modes, from the most tolerant to the strictest
+const (
+	modeTolerant = iota
+	modeInflected
+	modeStrict
+)
+
+// Grade returns the best score the answer can get compared to any of the right answers
+func Grade(answer string, right []string) int {
+	return gradeAll(answer, right, modeTolerant)
+}
+
+// GradeInflected grades like Grade, but answers only differing in a determiner or in the ending of a word are wrong
+// instead of typos, used by games asking inflected forms where the ending is the point of the question
+func GradeInflected(answer string, right []string) int {
+	return gradeAll(answer, right, modeInflected)
+}
+
+// GradeStrict grades like Grade, but tolerates no typos and no wrong articles, so that wrong endings, articles and
+// umlauts are not given partial scores, used by games drilling inflection
+func GradeStrict(answer string, right []string) int {
+	return gradeAll(answer, right, modeStrict)
+}
+
+func gradeAll(answer string, right []string, mode int) int {
+	var best = ScoreWrong
+
+	for _, r := range right {
+		if score := gradeOne(answer, r, mode); score > best {
+			best = score
+		}
+	}
+
+	return best
+}
+
+// gradeOne grades an answer, right answers starting with an article are also graded without it, losing ArticlePenalty points
+func gradeOne(answer, right string, mode int) int {
+	score := gradeText(answer, right, mode)
+
+	rightArticle, rightWord := splitArticle(right)
+	if mode == modeStrict || rightArticle == "" || rightWord == "" {
+		return score
+	}
+
+	answerArticle, answerWord := splitArticle(answer)
+	if answerArticle == "" {
+		answerWord = normalize(answer)
+	}
+
+	wordScore := gradeText(answerWord, rightWord, mode)
+	if answerArticle != rightArticle {
+		wordScore -= ArticlePenalty
+	}
+
+	if wordScore > score {
+		return wordScore
+	}
+
+	return score
+}
+
+// gradeText accepts umlauts and ß typed as ae, oe, ue and ss, capitalization mistakes and typos of longer words lose points,
+// typos are not tolerated in strict mode, nor wrong endings and determiners when grading inflected forms
+func gradeText(answer, right string, mode int) int {
+	answer, right = normalize(answer), normalize(right)
+
+	if answer == "" || right == "" {
+		return ScoreWrong
+	}
+
+	if answer == right {
+		return ScoreRight
+	}
+
+	answer, right = transliterations.Replace(answer), transliterations.Replace(right)
+	if answer == right {
+		return ScoreRight
+	}
+
+	answer, right = strings.ToLower(answer), strings.ToLower(right)
+	if answer == right {
+		return ScoreCapitalization
+	}
+
+	if mode == modeStrict || (mode == modeInflected && isInflected(answer, right)) {
+		return ScoreWrong
+	}
+
+	length := utf8.RuneCountInString(right)
+	distance := Distance(answer, right)
+
+	if distance == 1 && length >= minTypoLength {
+		return ScoreTypo
+	}
+
+	if distance == 2 && length >= minTwoTyposLength {
+		return ScoreTwoTypos
+	}
+
+	return ScoreWrong
+}
+
+// isInflected checks if an answer differs from the right one in a determiner or in the ending of a word
+func isInflected(answer, right string) bool {
+	answerWords, rightWords := strings.Fields(answer), strings.Fields(right)
+	if len(answerWords) != len(rightWords) {
+		return false
+	}
+
+	for i, rightWord := range rightWords {
+		answerWord := answerWords[i]
+		if answerWord == rightWord {
+			continue
+		}
+
+		if util.StringIn(rightWord, determiners) && util.StringIn(answerWord, determiners) {
+			return true
+		}
+
+		prefix := commonPrefixLength(answerWord, rightWord)
+		if util.StringIn(answerWord[prefix:], endings) && util.StringIn(rightWord[prefix:], endings) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// commonPrefixLength returns the length of the longest common prefix of two strings in bytes, not splitting runes
+func commonPrefixLength(a, b string) int {
+	var length int
+
+	for i, r := range a {
+		if !strings.HasPrefix(b[i:], string(r)) {
+			break
+		}
+
+		length = i + utf8.RuneLen(r)
+	}
+
+	return length
+}
+
+// normalize trims the answer and collapses whitespace
+func normalize(answer string) string {
+	return strings.Join(strings.Fields(answer), " ")
+}
+
+// splitArticle splits a definite article from the beginning of a text
+func splitArticle(text string) (string, string) {
+	parts := strings.SplitN(normalize(text), " ", 2)
+
+	article := strings.ToLower(parts[0])
+	if len(parts) < 2 {
+		return "", normalize(text)
+	}
+
+	for _, a := range articles {
+		if a == article {
+			return article, parts[1]
+		}
+	}
+
+	return "", normalize(text)
+}
+
+// Distance returns the Levenshtein distance of two strings, counting runes
+func Distance(a, b string) int {
+	var (
+		ra       = []rune(a)
+		rb       = []rune(b)
+		previous = make([]int, len(rb)+1)
+		current  = make([]int, len(rb)+1)
+	)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			current[j] = minInt(previous[j]+1, minInt(current[j-1]+1, previous[j-1]+cost))
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(rb)]
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
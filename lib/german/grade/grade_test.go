@@ -0,0 +1,98 @@
+package grade
+
+import "testing"
+
+var gradeCases = []struct {
+	answer   string
+	right    []string
+	grader   func(string, []string) int
+	expected int
+}{
+	{"der Tisch", []string{"der Tisch"}, Grade, ScoreRight},
+	{"  der   Tisch ", []string{"der Tisch"}, Grade, ScoreRight},
+	{"das Mädchen", []string{"das Mädchen"}, Grade, ScoreRight},
+	{"das Maedchen", []string{"das Mädchen"}, Grade, ScoreRight},
+	{"die Strasse", []string{"die Straße"}, Grade, ScoreRight},
+	{"der tisch", []string{"der Tisch"}, Grade, ScoreCapitalization},
+	{"die Tisch", []string{"der Tisch"}, Grade, ScoreRight - ArticlePenalty},
+	{"Tisch", []string{"der Tisch"}, Grade, ScoreRight - ArticlePenalty},
+	{"die Tisch", []string{"der Tisch", "die Tisch"}, Grade, ScoreRight},
+	{"der Tish", []string{"der Tisch"}, Grade, ScoreTypo},
+	{"die Tish", []string{"der Tisch"}, Grade, ScoreTypo - ArticlePenalty},
+	{"machst", []string{"machst"}, Grade, ScoreRight},
+	{"machts", []string{"machst"}, Grade, ScoreWrong},
+	{"macht", []string{"machst"}, Grade, ScoreTypo},
+	{"Bet", []string{"Bett"}, Grade, ScoreTypo},
+	{"Hau", []string{"Haus"}, Grade, ScoreTypo},
+	{"Ster", []string{"Stern"}, Grade, ScoreTypo},
+	{"Fenstr", []string{"Fenster"}, Grade, ScoreTypo},
+	{"das Bet", []string{"das Bett"}, Grade, ScoreTypo},
+	{"Zeitung", []string{"Zeitungen"}, Grade, ScoreTwoTypos},
+	{"macht", []string{"machst"}, GradeInflected, ScoreWrong},
+	{"machts", []string{"machst"}, GradeInflected, ScoreWrong},
+	{"machs", []string{"machst"}, GradeInflected, ScoreWrong},
+	{"mahcst", []string{"machst"}, GradeInflected, ScoreWrong},
+	{"arbieten", []string{"arbeiten"}, GradeInflected, ScoreTwoTypos},
+	{"arbeten", []string{"arbeiten"}, GradeInflected, ScoreTypo},
+	{"arbeitet", []string{"arbeiten"}, GradeInflected, ScoreWrong},
+	{"den Kind", []string{"dem Kind"}, GradeInflected, ScoreWrong},
+	{"gehe", []string{"gehen"}, GradeInflected, ScoreWrong},
+	{"großer", []string{"großen"}, GradeInflected, ScoreWrong},
+	{"kein Tisch", []string{"keinen Tisch"}, GradeInflected, ScoreWrong},
+	{"macht", []string{"machst"}, GradeStrict, ScoreWrong},
+	{"Tish", []string{"Tisch"}, GradeStrict, ScoreWrong},
+	{"Tisch", []string{"Tisch"}, GradeStrict, ScoreRight},
+	{"Strasse", []string{"Straße"}, GradeStrict, ScoreRight},
+	{"den kindern", []string{"den Kindern"}, GradeStrict, ScoreCapitalization},
+	{"den Kind", []string{"dem Kind"}, GradeStrict, ScoreWrong},
+	{"dem Kindern", []string{"den Kindern"}, GradeStrict, ScoreWrong},
+	{"gehe", []string{"gehen"}, GradeStrict, ScoreWrong},
+	{"großer", []string{"großen"}, GradeStrict, ScoreWrong},
+	{"einem", []string{"einen"}, GradeStrict, ScoreWrong},
+	{"kein Tisch", []string{"keinen Tisch"}, GradeStrict, ScoreWrong},
+	{"Mutter", []string{"Mütter", "die Mütter"}, GradeStrict, ScoreWrong},
+	{"Zeitung", []string{"Zeitungen", "die Zeitungen"}, GradeStrict, ScoreWrong},
+	{"Kinder", []string{"die Kinder"}, GradeStrict, ScoreWrong},
+	{"die", []string{"der"}, Grade, ScoreWrong},
+	{"den", []string{"der"}, Grade, ScoreWrong},
+	{"Verantwortnug", []string{"Verantwortung"}, Grade, ScoreTwoTypos},
+	{"Stuhl", []string{"der Tisch"}, Grade, ScoreWrong},
+	{"", []string{"der Tisch"}, Grade, ScoreWrong},
+	{"Tisch", []string{}, Grade, ScoreWrong},
+}
+
+func TestGrade(t *testing.T) {
+	for num, testCase := range gradeCases {
+		actual := testCase.grader(testCase.answer, testCase.right)
+
+		if actual != testCase.expected {
+			t.Fatalf("Case #%d: Expected score %d for '%s', got: %d", num+1, testCase.expected, testCase.answer, actual)
+		}
+	}
+
+	t.Log(len(gradeCases), "test cases")
+}
+
+var distanceCases = []struct {
+	a, b     string
+	expected int
+}{
+	{"", "", 0},
+	{"Tisch", "Tisch", 0},
+	{"Tisch", "Tish", 1},
+	{"Tisch", "", 5},
+	{"Mädchen", "Madchen", 1},
+	{"kitten", "sitting", 3},
+}
+
+func TestDistance(t *testing.T) {
+	for num, testCase := range distanceCases {
+		actual := Distance(testCase.a, testCase.b)
+
+		if actual != testCase.expected {
+			t.Fatalf("Case #%d: Expected distance %d, got: %d", num+1, testCase.expected, actual)
+		}
+	}
+
+	t.Log(len(distanceCases), "test cases")
+}
@@ -0,0 +1,105 @@
+package numeral
+
+import (
+	"fmt"
+
+	"github.com/peteraba/d5/lib/util"
+)
+
+// hourName returns the hour on the 12 hour clock as used in colloquial time expressions
+func hourName(hour int) string {
+	hour = hour % 12
+	if hour == 0 {
+		hour = 12
+	}
+
+	return Cardinal(hour)
+}
+
+// ClockTimes returns the colloquial expressions of a time, minutes are expected to be a multiple of five
+func ClockTimes(hour, minute int) []string {
+	var (
+		current = hourName(hour)
+		next    = hourName(hour + 1)
+		result  = []string{}
+	)
+
+	switch minute {
+	case 0:
+		if current == "eins" {
+			result = append(result, "ein Uhr", "eins")
+		} else {
+			result = append(result, current+" Uhr", current)
+		}
+		break
+	case 5, 10, 20:
+		result = append(result, Cardinal(minute)+" nach "+current)
+		if minute == 20 {
+			result = append(result, "zehn vor halb "+next)
+		}
+		break
+	case 15:
+		result = append(result, "Viertel nach "+current, "viertel "+next)
+		break
+	case 25:
+		result = append(result, "fünf vor halb "+next)
+		break
+	case 30:
+		result = append(result, "halb "+next)
+		break
+	case 35:
+		result = append(result, "fünf nach halb "+next)
+		break
+	case 40:
+		result = append(result, "zwanzig vor "+next, "zehn nach halb "+next)
+		break
+	case 45:
+		result = append(result, "Viertel vor "+next, "dreiviertel "+next)
+		break
+	case 50, 55:
+		result = append(result, Cardinal(60-minute)+" vor "+next)
+		break
+	}
+
+	official := OfficialTime(hour, minute)
+	if !util.StringIn(official, result) {
+		result = append(result, official)
+	}
+
+	return result
+}
+
+// OfficialTime returns a time as read out on the 24 hour clock, e.g. "fünfzehn Uhr zwanzig"
+func OfficialTime(hour, minute int) string {
+	hours := Cardinal(hour % 24)
+	if hours == "eins" {
+		hours = "ein"
+	}
+
+	if minute == 0 {
+		return hours + " Uhr"
+	}
+
+	return hours + " Uhr " + Cardinal(minute)
+}
+
+// DigitalTimes returns the ways a time can be written with digits on both the 12 and the 24 hour clock
+func DigitalTimes(hour, minute int) []string {
+	result := []string{}
+
+	twelve := hour % 12
+	if twelve == 0 {
+		twelve = 12
+	}
+
+	for _, h := range []int{hour % 24, twelve, twelve + 12} {
+		for _, format := range []string{"%d:%02d", "%02d:%02d", "%d.%02d"} {
+			text := fmt.Sprintf(format, h, minute)
+			if h < 24 && !util.StringIn(text, result) {
+				result = append(result, text)
+			}
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,55 @@
+package numeral
+
+import (
+	"strings"
+	"testing"
+)
+
+var clockTimesCases = []struct {
+	hour, minute int
+	expected     []string
+}{
+	{3, 0, []string{"drei Uhr", "drei"}},
+	{13, 0, []string{"ein Uhr", "eins", "dreizehn Uhr"}},
+	{15, 15, []string{"Viertel nach drei", "viertel vier", "fünfzehn Uhr fünfzehn"}},
+	{4, 30, []string{"halb fünf", "vier Uhr dreißig"}},
+	{11, 30, []string{"halb zwölf", "elf Uhr dreißig"}},
+	{12, 30, []string{"halb eins", "zwölf Uhr dreißig"}},
+	{8, 45, []string{"Viertel vor neun", "dreiviertel neun", "acht Uhr fünfundvierzig"}},
+	{1, 20, []string{"zwanzig nach eins", "zehn vor halb zwei", "ein Uhr zwanzig"}},
+	{9, 25, []string{"fünf vor halb zehn", "neun Uhr fünfundzwanzig"}},
+	{23, 55, []string{"fünf vor zwölf", "dreiundzwanzig Uhr fünfundfünfzig"}},
+}
+
+func TestClockTimes(t *testing.T) {
+	for _, testCase := range clockTimesCases {
+		actual := ClockTimes(testCase.hour, testCase.minute)
+
+		if strings.Join(actual, ",") != strings.Join(testCase.expected, ",") {
+			t.Fatalf("Clock times of %d:%02d are wrong. Expected: '%v', got: '%v'.", testCase.hour, testCase.minute, testCase.expected, actual)
+		}
+	}
+
+	t.Log(len(clockTimesCases), "test cases")
+}
+
+var digitalTimesCases = []struct {
+	hour, minute int
+	expected     []string
+}{
+	{15, 15, []string{"15:15", "15.15", "3:15", "03:15", "3.15"}},
+	{9, 5, []string{"9:05", "09:05", "9.05", "21:05", "21.05"}},
+	{0, 30, []string{"0:30", "00:30", "0.30", "12:30", "12.30"}},
+}
+
+func TestDigitalTimes(t *testing.T) {
+	for _, testCase := range digitalTimesCases {
+		actual := DigitalTimes(testCase.hour, testCase.minute)
+
+		if strings.Join(actual, ",") != strings.Join(testCase.expected, ",") {
+			t.Fatalf("Digital times of %d:%02d are wrong. Expected: '%v', got: '%v'.", testCase.hour, testCase.minute, testCase.expected, actual)
+		}
+	}
+
+	t.Log(len(digitalTimesCases), "test cases")
+}
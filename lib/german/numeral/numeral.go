@@ -0,0 +1,170 @@
+package numeral
+
+import "strings"
+
+// MaxCardinal is the largest number spelled out
+const MaxCardinal = 999999
+
+var (
+	units = []string{"null", "eins", "zwei", "drei", "vier", "fünf", "sechs", "sieben", "acht", "neun"}
+	teens = []string{"zehn", "elf", "zwölf", "dreizehn", "vierzehn", "fünfzehn", "sechzehn", "siebzehn", "achtzehn", "neunzehn"}
+	tens  = []string{"", "", "zwanzig", "dreißig", "vierzig", "fünfzig", "sechzig", "siebzig", "achtzig", "neunzig"}
+
+	// irregularOrdinals lists the ordinal stems not formed by adding -t to the cardinal
+	irregularOrdinals = map[int]string{
+		1: "erst",
+		3: "dritt",
+		7: "siebt",
+		8: "acht",
+	}
+
+	Months = []string{
+		"Januar", "Februar", "März", "April", "Mai", "Juni",
+		"Juli", "August", "September", "Oktober", "November", "Dezember",
+	}
+)
+
+// Cardinal spells out a number between 0 and MaxCardinal, e.g. "einundzwanzig", an empty string is returned for other numbers
+func Cardinal(n int) string {
+	if n < 0 || n > MaxCardinal {
+		return ""
+	}
+
+	if n == 0 {
+		return units[0]
+	}
+
+	return cardinal(n, true)
+}
+
+// Cardinals returns the spelled out forms of a number, "hundert" and "tausend" are also accepted with a leading "ein"
+func Cardinals(n int) []string {
+	result := []string{}
+
+	spelled := Cardinal(n)
+	if spelled == "" {
+		return result
+	}
+
+	result = append(result, spelled)
+
+	if strings.HasPrefix(spelled, "hundert") || strings.HasPrefix(spelled, "tausend") {
+		result = append(result, "ein"+spelled)
+	}
+
+	return result
+}
+
+// cardinal spells out a positive number, isFinal marks the end of the number where 1 is spelled "eins" instead of "ein"
+func cardinal(n int, isFinal bool) string {
+	var (
+		thousands = n / 1000
+		rest      = n % 1000
+		result    string
+	)
+
+	if thousands == 1 {
+		result = "tausend"
+	} else if thousands > 1 {
+		result = belowThousand(thousands, false) + "tausend"
+	}
+
+	if rest > 0 {
+		result += belowThousand(rest, isFinal)
+	}
+
+	return result
+}
+
+func belowThousand(n int, isFinal bool) string {
+	var (
+		hundreds = n / 100
+		rest     = n % 100
+		result   string
+	)
+
+	if hundreds == 1 {
+		result = "hundert"
+	} else if hundreds > 1 {
+		result = units[hundreds] + "hundert"
+	}
+
+	if rest > 0 {
+		result += belowHundred(rest, isFinal)
+	}
+
+	return result
+}
+
+func belowHundred(n int, isFinal bool) string {
+	if n == 1 && !isFinal {
+		return "ein"
+	}
+
+	if n < 10 {
+		return units[n]
+	}
+
+	if n < 20 {
+		return teens[n-10]
+	}
+
+	if n%10 == 0 {
+		return tens[n/10]
+	}
+
+	unit := units[n%10]
+	if n%10 == 1 {
+		unit = "ein"
+	}
+
+	return unit + "und" + tens[n/10]
+}
+
+// OrdinalStem returns the stem of an ordinal number without its ending, e.g. "dritt" or "einundzwanzigst"
+func OrdinalStem(n int) string {
+	if n < 1 || n > MaxCardinal {
+		return ""
+	}
+
+	rest := n % 100
+	base := n - rest
+
+	if rest == 0 || rest >= 20 {
+		return cardinal(n, true) + "st"
+	}
+
+	prefix := ""
+	if base > 0 {
+		prefix = cardinal(base, false)
+	}
+
+	if stem, ok := irregularOrdinals[rest]; ok {
+		return prefix + stem
+	}
+
+	return prefix + belowHundred(rest, true) + "t"
+}
+
+// Ordinal returns the ordinal number following a definite article in nominative, e.g. "der dritte"
+func Ordinal(n int) string {
+	stem := OrdinalStem(n)
+	if stem == "" {
+		return ""
+	}
+
+	return "der " + stem + "e"
+}
+
+// Date returns a date in dative as used for answering when something happens, e.g. "am dritten Mai"
+func Date(day, month int) []string {
+	stem := OrdinalStem(day)
+	if stem == "" || month < 1 || month > len(Months) {
+		return []string{}
+	}
+
+	return []string{
+		"am " + stem + "en " + Months[month-1],
+		"am " + stem + "en " + OrdinalStem(month) + "en",
+	}
+}
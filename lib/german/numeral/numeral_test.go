@@ -0,0 +1,122 @@
+package numeral
+
+import (
+	"strings"
+	"testing"
+)
+
+var cardinalCases = []struct {
+	n        int
+	expected string
+}{
+	{0, "null"},
+	{1, "eins"},
+	{7, "sieben"},
+	{11, "elf"},
+	{16, "sechzehn"},
+	{17, "siebzehn"},
+	{20, "zwanzig"},
+	{21, "einundzwanzig"},
+	{30, "dreißig"},
+	{99, "neunundneunzig"},
+	{100, "hundert"},
+	{101, "hunderteins"},
+	{121, "hunderteinundzwanzig"},
+	{200, "zweihundert"},
+	{1000, "tausend"},
+	{1001, "tausendeins"},
+	{2345, "zweitausenddreihundertfünfundvierzig"},
+	{21000, "einundzwanzigtausend"},
+	{101000, "hunderteintausend"},
+	{-1, ""},
+	{1000000, ""},
+}
+
+func TestCardinal(t *testing.T) {
+	for _, testCase := range cardinalCases {
+		actual := Cardinal(testCase.n)
+
+		if actual != testCase.expected {
+			t.Fatalf("Cardinal of %d is wrong. Expected: '%s', got: '%s'.", testCase.n, testCase.expected, actual)
+		}
+	}
+
+	t.Log(len(cardinalCases), "test cases")
+}
+
+var cardinalsCases = []struct {
+	n        int
+	expected []string
+}{
+	{21, []string{"einundzwanzig"}},
+	{100, []string{"hundert", "einhundert"}},
+	{1500, []string{"tausendfünfhundert", "eintausendfünfhundert"}},
+	{2000, []string{"zweitausend"}},
+}
+
+func TestCardinals(t *testing.T) {
+	for _, testCase := range cardinalsCases {
+		actual := Cardinals(testCase.n)
+
+		if strings.Join(actual, ",") != strings.Join(testCase.expected, ",") {
+			t.Fatalf("Cardinals of %d are wrong. Expected: '%v', got: '%v'.", testCase.n, testCase.expected, actual)
+		}
+	}
+
+	t.Log(len(cardinalsCases), "test cases")
+}
+
+var ordinalCases = []struct {
+	n        int
+	expected string
+}{
+	{1, "der erste"},
+	{2, "der zweite"},
+	{3, "der dritte"},
+	{7, "der siebte"},
+	{8, "der achte"},
+	{12, "der zwölfte"},
+	{19, "der neunzehnte"},
+	{20, "der zwanzigste"},
+	{23, "der dreiundzwanzigste"},
+	{100, "der hundertste"},
+	{101, "der hunderterste"},
+	{103, "der hundertdritte"},
+	{1000, "der tausendste"},
+	{1021, "der tausendeinundzwanzigste"},
+	{0, ""},
+}
+
+func TestOrdinal(t *testing.T) {
+	for _, testCase := range ordinalCases {
+		actual := Ordinal(testCase.n)
+
+		if actual != testCase.expected {
+			t.Fatalf("Ordinal of %d is wrong. Expected: '%s', got: '%s'.", testCase.n, testCase.expected, actual)
+		}
+	}
+
+	t.Log(len(ordinalCases), "test cases")
+}
+
+var dateCases = []struct {
+	day, month int
+	expected   []string
+}{
+	{3, 5, []string{"am dritten Mai", "am dritten fünften"}},
+	{1, 1, []string{"am ersten Januar", "am ersten ersten"}},
+	{31, 12, []string{"am einunddreißigsten Dezember", "am einunddreißigsten zwölften"}},
+	{1, 13, []string{}},
+}
+
+func TestDate(t *testing.T) {
+	for _, testCase := range dateCases {
+		actual := Date(testCase.day, testCase.month)
+
+		if strings.Join(actual, ",") != strings.Join(testCase.expected, ",") {
+			t.Fatalf("Date of %d.%d. is wrong. Expected: '%v', got: '%v'.", testCase.day, testCase.month, testCase.expected, actual)
+		}
+	}
+
+	t.Log(len(dateCases), "test cases")
+}
@@ -5,45 +5,18 @@ import (
 	"testing"
 	"time"
 
-	"github.com/peteraba/d5/lib/general"
 	"github.com/peteraba/d5/lib/german/entity"
 )
 
-func newEmptySuperword(category string) Superword {
-	return Superword{
-		entity.DefaultWord{
-			"",
-			[]entity.Meaning{},
-			[]entity.Meaning{},
-			category,
-			"",
-			time.Now(),
-			5,
-			[]string{},
-			[]string{},
-			[]*general.Score{},
+// newLegacyDocument creates a document as it was stored before type discriminators were introduced
+func newLegacyDocument(category string) map[string]interface{} {
+	return map[string]interface{}{
+		"word": map[string]interface{}{
+			"german":   "",
+			"category": category,
+			"learned":  time.Now(),
+			"score":    5,
 		},
-		"",
-		[]entity.Auxiliary{},
-		entity.Prefix{},
-		"",
-		"",
-		[]string{},
-		[]string{},
-		[]string{},
-		[]string{},
-		[]string{},
-		[]string{},
-		[]string{},
-		[]string{},
-		entity.ReflexiveWithout,
-		[]entity.Argument{},
-		[]entity.Article{},
-		[]string{},
-		[]string{},
-		false,
-		[]string{},
-		[]string{},
 	}
 }
 
@@ -68,23 +41,23 @@ func newEmptyVerb() *entity.Verb {
 }
 
 var parseWordCases = []struct {
-	superwords []Superword
-	words      []entity.Word
+	documents []interface{}
+	words     []entity.Word
 }{
 	{
-		[]Superword{
-			newEmptySuperword("verb"),
+		[]interface{}{
+			newLegacyDocument("verb"),
 		},
 		[]entity.Word{
 			newEmptyVerb(),
 		},
 	},
 	{
-		[]Superword{
-			newEmptySuperword("idiom"),
-			newEmptySuperword("noun"),
-			newEmptySuperword("adj"),
-			newEmptySuperword("idiom"),
+		[]interface{}{
+			newLegacyDocument("idiom"),
+			newLegacyDocument("noun"),
+			newLegacyDocument("adj"),
+			newLegacyDocument("idiom"),
 		},
 		[]entity.Word{
 			newEmptyIdiom(),
@@ -97,7 +70,7 @@ var parseWordCases = []struct {
 
 func TestParseWords(t *testing.T) {
 	for num, testCase := range parseWordCases {
-		b, err := json.Marshal(testCase.superwords)
+		b, err := json.Marshal(testCase.documents)
 
 		if err != nil {
 			t.Fatal(err)
@@ -143,21 +116,47 @@ func TestSliceAppendErrors(t *testing.T) {
 func TestDictionaryCreation(t *testing.T) {
 	var d Dictionary
 
-	superwords := []Superword{
-		newEmptySuperword("verb"),
-		newEmptySuperword("verb"),
-		newEmptySuperword("noun"),
-		newEmptySuperword("verb"),
-		newEmptySuperword("noun"),
-		newEmptySuperword("noun"),
-		newEmptySuperword("adj"),
-		newEmptySuperword("idiom"),
-		newEmptySuperword("hello"),
+	words := []entity.Word{
+		newEmptyVerb(),
+		newEmptyVerb(),
+		newEmptyNoun(),
+		newEmptyVerb(),
+		newEmptyNoun(),
+		newEmptyNoun(),
+		newEmptyAdjective(),
+		&entity.Any{DefaultWord: entity.DefaultWord{Category: "idiom"}},
+		&entity.Any{DefaultWord: entity.DefaultWord{Category: "hello"}},
 	}
 
-	d = SuperwordsToDictionary(superwords)
+	documents := []interface{}{
+		newLegacyDocument("verb"),
+		newLegacyDocument("verb"),
+		newLegacyDocument("noun"),
+		newLegacyDocument("verb"),
+		newLegacyDocument("noun"),
+		newLegacyDocument("noun"),
+		newLegacyDocument("adj"),
+		newLegacyDocument("idiom"),
+		newLegacyDocument("hello"),
+	}
+
+	d = WordsToDictionary(words)
+
+	if len(words) != d.GetCount() {
+		t.Fatalf("Wrong count received. Expected: %d, got: %d.", len(words), d.GetCount())
+	}
+
+	b, err := json.Marshal(documents)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d, err = ParseDictionary(b)
+	if err != nil {
+		t.Fatal(err)
+	}
 
-	if len(superwords) != d.GetCount() {
-		t.Fatalf("Wrong count received. Expected: %d, got: %d.", len(superwords), d.GetCount())
+	if len(documents) != d.GetCount() {
+		t.Fatalf("Wrong count received for legacy documents. Expected: %d, got: %d.", len(documents), d.GetCount())
 	}
 }
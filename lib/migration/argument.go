@@ -0,0 +1,57 @@
+package migration
+
+import (
+	"strings"
+
+	"github.com/peteraba/d5/lib/german/entity"
+	"github.com/peteraba/d5/lib/util"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// genitivePrepositions are the prepositions taking genitive, their genitive case is kept as it was noted most likely
+var genitivePrepositions = []string{
+	"abseits", "angesichts", "anhand", "anlässlich", "anstatt", "aufgrund", "außerhalb", "bezüglich", "infolge",
+	"innerhalb", "mithilfe", "oberhalb", "statt", "trotz", "unterhalb", "während", "wegen",
+}
+
+// MigrateArgumentCases clears the genitive case stored for verb arguments noted without a case, arguments were parsed
+// as genitive by default before, the raw text is not stored so only the arguments with a preposition taking genitive
+// or without a preposition keep it
+func MigrateArgumentCases(mgoCollection *mgo.Collection, options Options) (int, error) {
+	return migrateDocuments(mgoCollection, func(document bson.M) (bool, error) {
+		return ClearArgumentCases(document), nil
+	})
+}
+
+func ClearArgumentCases(document bson.M) bool {
+	var changed bool
+
+	arguments, ok := document["arguments"].([]interface{})
+	if !ok {
+		return false
+	}
+
+	for _, rawArgument := range arguments {
+		argument, ok := rawArgument.(bson.M)
+		if !ok {
+			continue
+		}
+
+		c, _ := argument["case"].(string)
+		preposition, _ := argument["prep"].(string)
+		if c != string(entity.CaseGenitive) || preposition == "" {
+			continue
+		}
+
+		if util.StringIn(strings.ToLower(preposition), genitivePrepositions) {
+			continue
+		}
+
+		argument["case"] = ""
+
+		changed = true
+	}
+
+	return changed
+}
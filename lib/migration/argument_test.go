@@ -0,0 +1,43 @@
+package migration
+
+import (
+	"testing"
+
+	"gopkg.in/mgo.v2/bson"
+)
+
+var clearArgumentCasesCases = []struct {
+	preposition, c, expected string
+	changed                  bool
+}{
+	{"auf", "G", "", true},
+	{"mit", "G", "", true},
+	{"wegen", "G", "G", false},
+	{"Trotz", "G", "G", false},
+	{"", "G", "G", false},
+	{"auf", "A", "A", false},
+	{"mit", "D", "D", false},
+	{"auf", "", "", false},
+}
+
+func TestClearArgumentCases(t *testing.T) {
+	for num, testCase := range clearArgumentCasesCases {
+		argument := bson.M{"prep": testCase.preposition, "case": testCase.c}
+		document := bson.M{"word": bson.M{"category": "verb"}, "arguments": []interface{}{argument}}
+
+		changed := ClearArgumentCases(document)
+		if changed != testCase.changed {
+			t.Fatalf("Case #%d: Expected changed to be %v, got %v.", num+1, testCase.changed, changed)
+		}
+
+		if argument["case"] != testCase.expected {
+			t.Fatalf("Case #%d: Expected: %s, got: %v.", num+1, testCase.expected, argument["case"])
+		}
+	}
+
+	if ClearArgumentCases(bson.M{"word": bson.M{"category": "noun"}}) {
+		t.Fatalf("Expected documents without arguments not to change.")
+	}
+
+	t.Log(len(clearArgumentCasesCases), "test cases")
+}
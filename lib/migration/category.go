@@ -0,0 +1,35 @@
+package migration
+
+import (
+	"github.com/peteraba/d5/lib/german"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// MigrateCanonicalCategories replaces category aliases (e.g. adjective) with the canonical category name (e.g. adj)
+func MigrateCanonicalCategories(mgoCollection *mgo.Collection, options Options) (int, error) {
+	return migrateDocuments(mgoCollection, func(document bson.M) (bool, error) {
+		return CanonicaliseCategory(document), nil
+	})
+}
+
+func CanonicaliseCategory(document bson.M) bool {
+	word, ok := document["word"].(bson.M)
+	if !ok {
+		return false
+	}
+
+	category, ok := word["category"].(string)
+	if !ok {
+		return false
+	}
+
+	canonical := german.CanonicalCategory(category)
+	if canonical == category {
+		return false
+	}
+
+	word["category"] = canonical
+
+	return true
+}
@@ -0,0 +1,35 @@
+package migration
+
+import (
+	"testing"
+
+	"gopkg.in/mgo.v2/bson"
+)
+
+var canonicaliseCategoryCases = []struct {
+	category, expected string
+	changed            bool
+}{
+	{"adjective", "adj", true},
+	{"adj", "adj", false},
+	{"noun", "noun", false},
+	{"unknown", "unknown", false},
+}
+
+func TestCanonicaliseCategory(t *testing.T) {
+	for num, testCase := range canonicaliseCategoryCases {
+		document := bson.M{"word": bson.M{"category": testCase.category}}
+
+		changed := CanonicaliseCategory(document)
+		if changed != testCase.changed {
+			t.Fatalf("Case #%d: Expected changed to be %v, got %v.", num+1, testCase.changed, changed)
+		}
+
+		actual := document["word"].(bson.M)["category"]
+		if actual != testCase.expected {
+			t.Fatalf("Case #%d: Expected: %s, got: %v.", num+1, testCase.expected, actual)
+		}
+	}
+
+	t.Log(len(canonicaliseCategoryCases), "test cases")
+}
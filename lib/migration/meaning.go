@@ -0,0 +1,108 @@
+package migration
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/peteraba/d5/lib/german/entity"
+	"github.com/peteraba/d5/lib/util"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+const synonymSeparator = ","
+
+var meaningFields = []string{"english", "third"}
+
+// MigrateMeaningSynonyms turns the comma separated "main" field of stored meanings into a "synonyms" list
+func MigrateMeaningSynonyms(mgoCollection *mgo.Collection, options Options) (int, error) {
+	return migrateDocuments(mgoCollection, func(document bson.M) (bool, error) {
+		return SplitMeaningSynonyms(document), nil
+	})
+}
+
+// MigrateMeaningLanguages moves the "english" and "third" meaning lists into the "meanings" map keyed by language
+func MigrateMeaningLanguages(mgoCollection *mgo.Collection, options Options) (int, error) {
+	return migrateDocuments(mgoCollection, func(document bson.M) (bool, error) {
+		return MergeMeaningLanguages(document, options.ThirdLanguage)
+	})
+}
+
+func SplitMeaningSynonyms(document bson.M) bool {
+	var changed bool
+
+	word, ok := document["word"].(bson.M)
+	if !ok {
+		return false
+	}
+
+	for _, field := range meaningFields {
+		meanings, ok := word[field].([]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, rawMeaning := range meanings {
+			meaning, ok := rawMeaning.(bson.M)
+			if !ok {
+				continue
+			}
+
+			main, ok := meaning["main"].(string)
+			if !ok {
+				continue
+			}
+
+			meaning["synonyms"] = util.TrimSplit(main, synonymSeparator)
+			delete(meaning, "main")
+
+			changed = true
+		}
+	}
+
+	return changed
+}
+
+func MergeMeaningLanguages(document bson.M, thirdLanguage string) (bool, error) {
+	var (
+		meanings = bson.M{}
+		changed  bool
+	)
+
+	word, ok := document["word"].(bson.M)
+	if !ok {
+		return false, nil
+	}
+
+	if existing, ok := word["meanings"].(bson.M); ok {
+		meanings = existing
+	}
+
+	if english, ok := word["english"]; ok {
+		if list, ok := english.([]interface{}); ok && len(list) > 0 {
+			meanings[entity.LangEnglish] = list
+		}
+
+		delete(word, "english")
+		changed = true
+	}
+
+	if third, ok := word["third"]; ok {
+		if list, ok := third.([]interface{}); ok && len(list) > 0 {
+			if thirdLanguage == "" {
+				return false, errors.New(fmt.Sprintf("Language of third meanings is not set, document: %v", document["_id"]))
+			}
+
+			meanings[thirdLanguage] = list
+		}
+
+		delete(word, "third")
+		changed = true
+	}
+
+	if changed {
+		word["meanings"] = meanings
+	}
+
+	return changed, nil
+}
@@ -0,0 +1,146 @@
+package migration
+
+import (
+	"reflect"
+	"testing"
+
+	"gopkg.in/mgo.v2/bson"
+)
+
+var splitMeaningSynonymsCases = []struct {
+	document bson.M
+	expected bson.M
+	changed  bool
+}{
+	{
+		bson.M{"word": bson.M{
+			"english": []interface{}{
+				bson.M{"main": "expired, out of date", "parantheses": ""},
+				bson.M{"main": "stale", "parantheses": "food"},
+			},
+			"third": []interface{}{
+				bson.M{"main": "lejárt", "parantheses": ""},
+			},
+		}},
+		bson.M{"word": bson.M{
+			"english": []interface{}{
+				bson.M{"synonyms": []string{"expired", "out of date"}, "parantheses": ""},
+				bson.M{"synonyms": []string{"stale"}, "parantheses": "food"},
+			},
+			"third": []interface{}{
+				bson.M{"synonyms": []string{"lejárt"}, "parantheses": ""},
+			},
+		}},
+		true,
+	},
+	{
+		bson.M{"word": bson.M{
+			"english": []interface{}{
+				bson.M{"synonyms": []string{"expired"}, "parantheses": ""},
+			},
+		}},
+		bson.M{"word": bson.M{
+			"english": []interface{}{
+				bson.M{"synonyms": []string{"expired"}, "parantheses": ""},
+			},
+		}},
+		false,
+	},
+	{
+		bson.M{"_id": "foo"},
+		bson.M{"_id": "foo"},
+		false,
+	},
+}
+
+func TestSplitMeaningSynonyms(t *testing.T) {
+	for num, testCase := range splitMeaningSynonymsCases {
+		changed := SplitMeaningSynonyms(testCase.document)
+
+		if changed != testCase.changed {
+			t.Fatalf("Case #%d: Expected changed to be %v, got %v.", num+1, testCase.changed, changed)
+		}
+
+		if !reflect.DeepEqual(testCase.document, testCase.expected) {
+			t.Fatalf("Case #%d: Document is different from expected.\nExpected: %v\ngot: %v", num+1, testCase.expected, testCase.document)
+		}
+	}
+
+	t.Log(len(splitMeaningSynonymsCases), "test cases")
+}
+
+var mergeMeaningLanguagesCases = []struct {
+	document      bson.M
+	thirdLanguage string
+	expected      bson.M
+	changed       bool
+}{
+	{
+		bson.M{"word": bson.M{
+			"english": []interface{}{bson.M{"synonyms": []string{"expired"}}},
+			"third":   []interface{}{bson.M{"synonyms": []string{"lejárt"}}},
+		}},
+		"hu",
+		bson.M{"word": bson.M{
+			"meanings": bson.M{
+				"en": []interface{}{bson.M{"synonyms": []string{"expired"}}},
+				"hu": []interface{}{bson.M{"synonyms": []string{"lejárt"}}},
+			},
+		}},
+		true,
+	},
+	{
+		bson.M{"word": bson.M{
+			"english": []interface{}{bson.M{"synonyms": []string{"expired"}}},
+			"third":   []interface{}{},
+		}},
+		"",
+		bson.M{"word": bson.M{
+			"meanings": bson.M{
+				"en": []interface{}{bson.M{"synonyms": []string{"expired"}}},
+			},
+		}},
+		true,
+	},
+	{
+		bson.M{"word": bson.M{
+			"meanings": bson.M{"en": []interface{}{}},
+		}},
+		"hu",
+		bson.M{"word": bson.M{
+			"meanings": bson.M{"en": []interface{}{}},
+		}},
+		false,
+	},
+}
+
+func TestMergeMeaningLanguages(t *testing.T) {
+	for num, testCase := range mergeMeaningLanguagesCases {
+		changed, err := MergeMeaningLanguages(testCase.document, testCase.thirdLanguage)
+
+		if err != nil {
+			t.Fatalf("Case #%d: Unexpected error: %v", num+1, err)
+		}
+
+		if changed != testCase.changed {
+			t.Fatalf("Case #%d: Expected changed to be %v, got %v.", num+1, testCase.changed, changed)
+		}
+
+		if !reflect.DeepEqual(testCase.document, testCase.expected) {
+			t.Fatalf("Case #%d: Document is different from expected.\nExpected: %v\ngot: %v", num+1, testCase.expected, testCase.document)
+		}
+	}
+
+	t.Log(len(mergeMeaningLanguagesCases), "test cases")
+}
+
+func TestMergeMeaningLanguagesRequiresThirdLanguage(t *testing.T) {
+	document := bson.M{"word": bson.M{
+		"third": []interface{}{bson.M{"synonyms": []string{"lejárt"}}},
+	}}
+
+	_, err := MergeMeaningLanguages(document, "")
+	if err == nil {
+		t.Fatal("Expected an error when the language of third meanings is unknown.")
+	}
+}
@@ -0,0 +1,118 @@
+package migration
+
+import (
+	"log"
+	"time"
+
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+const (
+	migrationColl = "migration"
+)
+
+type Options struct {
+	// ThirdLanguage is the language code of the meanings stored in the legacy "third" field
+	ThirdLanguage string
+}
+
+type Migration struct {
+	Name    string
+	Migrate func(mgoCollection *mgo.Collection, options Options) (int, error)
+}
+
+// Migrations must only be appended to, names are used to track which ones were applied already
+var migrations = []Migration{
+	Migration{"001_meaning_synonyms", MigrateMeaningSynonyms},
+	Migration{"002_meaning_languages", MigrateMeaningLanguages},
+	Migration{"003_canonical_categories", MigrateCanonicalCategories},
+	Migration{"004_word_types", MigrateWordTypes},
+	Migration{"005_argument_cases", MigrateArgumentCases},
+}
+
+type appliedMigration struct {
+	Name      string    `bson:"_id" json:"name"`
+	Updated   int       `bson:"updated" json:"updated"`
+	AppliedAt time.Time `bson:"appliedAt" json:"appliedAt"`
+}
+
+func GetMigrations() []Migration {
+	return migrations
+}
+
+func Run(mgoDb *mgo.Database, collectionName string, options Options, isDebug bool) ([]string, error) {
+	var (
+		applied       = []string{}
+		count         int
+		err           error
+		mgoCollection = mgoDb.C(collectionName)
+		trackerColl   = mgoDb.C(migrationColl)
+	)
+
+	for _, migration := range migrations {
+		count, err = trackerColl.FindId(migration.Name).Count()
+		if err != nil {
+			return applied, err
+		}
+
+		if count > 0 {
+			continue
+		}
+
+		count, err = migration.Migrate(mgoCollection, options)
+		if err != nil {
+			log.Printf("Migration %s failed: %v\n", migration.Name, err)
+
+			return applied, err
+		}
+
+		if isDebug {
+			log.Printf("Migration %s updated %d documents\n", migration.Name, count)
+		}
+
+		err = trackerColl.Insert(appliedMigration{migration.Name, count, time.Now()})
+		if err != nil {
+			return applied, err
+		}
+
+		applied = append(applied, migration.Name)
+	}
+
+	return applied, nil
+}
+
+func migrateDocuments(mgoCollection *mgo.Collection, fn func(bson.M) (bool, error)) (int, error) {
+	var (
+		document = bson.M{}
+		updated  int
+		changed  bool
+		err      error
+	)
+
+	iter := mgoCollection.Find(bson.M{}).Iter()
+
+	for iter.Next(&document) {
+		changed, err = fn(document)
+		if err != nil {
+			iter.Close()
+
+			return updated, err
+		}
+
+		if changed {
+			err = mgoCollection.UpdateId(document["_id"], document)
+			if err != nil {
+				iter.Close()
+
+				return updated, err
+			}
+
+			updated++
+		}
+
+		document = bson.M{}
+	}
+
+	return updated, iter.Close()
+}
@@ -0,0 +1,44 @@
+package migration
+
+import (
+	"github.com/peteraba/d5/lib/german"
+	"github.com/peteraba/d5/lib/german/entity"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// MigrateWordTypes adds the type discriminator and schema version to documents stored without them
+func MigrateWordTypes(mgoCollection *mgo.Collection, options Options) (int, error) {
+	return migrateDocuments(mgoCollection, func(document bson.M) (bool, error) {
+		return AddWordType(document), nil
+	})
+}
+
+func AddWordType(document bson.M) bool {
+	if _, ok := document["type"].(string); ok {
+		return false
+	}
+
+	word, ok := document["word"].(bson.M)
+	if !ok {
+		return false
+	}
+
+	category, _ := word["category"].(string)
+
+	document["type"] = getWordType(document, category)
+	document["schema"] = entity.SchemaVersion
+
+	return true
+}
+
+// getWordType returns the type of the category unless only the common fields were stored, which happens for words that failed parsing
+func getWordType(document bson.M, category string) string {
+	for key := range document {
+		if key != "_id" && key != "word" {
+			return german.CategoryType(category)
+		}
+	}
+
+	return entity.TypeAny
+}
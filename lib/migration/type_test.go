@@ -0,0 +1,41 @@
+package migration
+
+import (
+	"testing"
+
+	"github.com/peteraba/d5/lib/german/entity"
+	"gopkg.in/mgo.v2/bson"
+)
+
+var addWordTypeCases = []struct {
+	document bson.M
+	expected string
+	changed  bool
+}{
+	{bson.M{"word": bson.M{"category": "noun"}, "article": []interface{}{1}}, entity.TypeNoun, true},
+	{bson.M{"word": bson.M{"category": "verb"}, "auxiliary": []interface{}{}}, entity.TypeVerb, true},
+	{bson.M{"word": bson.M{"category": "adjective"}, "comparative": []interface{}{}}, entity.TypeAdjective, true},
+	{bson.M{"word": bson.M{"category": "noun"}, "_id": "x"}, entity.TypeAny, true},
+	{bson.M{"word": bson.M{"category": "idiom"}}, entity.TypeAny, true},
+	{bson.M{"word": bson.M{"category": "unknown"}}, entity.TypeAny, true},
+	{bson.M{"word": bson.M{"category": "noun"}, "type": entity.TypeAny, "schema": 1}, entity.TypeAny, false},
+}
+
+func TestAddWordType(t *testing.T) {
+	for num, testCase := range addWordTypeCases {
+		changed := AddWordType(testCase.document)
+		if changed != testCase.changed {
+			t.Fatalf("Case #%d: Expected changed to be %v, got %v.", num+1, testCase.changed, changed)
+		}
+
+		if testCase.document["type"] != testCase.expected {
+			t.Fatalf("Case #%d: Expected: %s, got: %v.", num+1, testCase.expected, testCase.document["type"])
+		}
+
+		if testCase.document["schema"] != entity.SchemaVersion {
+			t.Fatalf("Case #%d: Expected schema: %d, got: %v.", num+1, entity.SchemaVersion, testCase.document["schema"])
+		}
+	}
+
+	t.Log(len(addWordTypeCases), "test cases")
+}
@@ -0,0 +1,197 @@
+package mistake
+
+import (
+	"math/rand"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/peteraba/d5/lib/german/entity"
+	"github.com/peteraba/d5/lib/german/grade"
+	"github.com/peteraba/d5/lib/mongo"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+const (
+	// Threshold is the lowest score not recorded as a mistake, answers with typos are still recorded
+	Threshold = grade.ScoreCapitalization
+	// maxAnswers is the number of latest answers listed in a group
+	maxAnswers = 5
+)
+
+// Mistake is a wrong answer given in a game together with the question asked
+type Mistake struct {
+	User         string    `json:"user" bson:"user"`
+	Game         string    `json:"game" bson:"game"`
+	QuestionType string    `json:"questionType,omitempty" bson:"questionType,omitempty"`
+	Pattern      string    `json:"pattern" bson:"pattern"`
+	Ending       string    `json:"ending,omitempty" bson:"ending,omitempty"`
+	WordIds      []string  `json:"wordIds,omitempty" bson:"wordIds,omitempty"`
+	Words        []string  `json:"words,omitempty" bson:"words,omitempty"`
+	Answer       string    `json:"answer" bson:"answer"`
+	Right        []string  `json:"right,omitempty" bson:"right,omitempty"`
+	Score        int       `json:"score" bson:"score"`
+	CreatedAt    time.Time `json:"createdAt" bson:"createdAt"`
+}
+
+// IsMistake checks if a score is low enough to be recorded
+func IsMistake(score int) bool {
+	return score < Threshold
+}
+
+// NewMistake creates a mistake classified by its pattern, words are the ones asked in the question
+func NewMistake(user, gameName, questionType, answer string, right []string, score int, wordIds []string, words ...entity.Word) Mistake {
+	pattern := Classify(gameName, questionType, answer, right, words...)
+
+	mistake := Mistake{
+		User:         user,
+		Game:         gameName,
+		QuestionType: questionType,
+		Pattern:      pattern.Name,
+		Ending:       pattern.Ending,
+		WordIds:      wordIds,
+		Answer:       answer,
+		Right:        right,
+		Score:        score,
+		CreatedAt:    time.Now(),
+	}
+
+	for _, word := range words {
+		if word != nil {
+			mistake.Words = append(mistake.Words, word.GetGerman())
+		}
+	}
+
+	return mistake
+}
+
+// GetCollection returns the collection of mistakes of a database
+func GetCollection(mgoDb *mgo.Database) *mgo.Collection {
+	return mgoDb.C(mongo.ParseMistakeCollection())
+}
+
+// Save stores a mistake
+func Save(mgoCollection *mgo.Collection, mistake Mistake) error {
+	return mgoCollection.Insert(mistake)
+}
+
+// Find returns the mistakes of a user, only the ones made in a game if the game name is given
+func Find(mgoCollection *mgo.Collection, user, gameName string) ([]Mistake, error) {
+	var (
+		mistakes = []Mistake{}
+		query    = bson.M{"user": user}
+	)
+
+	if gameName != "" {
+		query["game"] = gameName
+	}
+
+	err := mgoCollection.Find(query).Sort("-createdAt").All(&mistakes)
+
+	return mistakes, err
+}
+
+// Group is a list of mistakes of the same pattern in a game
+type Group struct {
+	Game         string    `json:"game"`
+	Pattern      string    `json:"pattern"`
+	QuestionType string    `json:"questionType,omitempty"`
+	Ending       string    `json:"ending,omitempty"`
+	Count        int       `json:"count"`
+	Words        []string  `json:"words,omitempty"`
+	Answers      []string  `json:"answers,omitempty"`
+	LastAt       time.Time `json:"lastAt"`
+}
+
+// GroupMistakes groups mistakes by game and pattern, the most frequent patterns come first
+func GroupMistakes(mistakes []Mistake) []Group {
+	var (
+		groups  = []Group{}
+		indexes = map[string]int{}
+	)
+
+	for _, mistake := range mistakes {
+		key := mistake.Game + "\n" + mistake.Pattern
+
+		index, ok := indexes[key]
+		if !ok {
+			index = len(groups)
+			indexes[key] = index
+			groups = append(groups, Group{Game: mistake.Game, Pattern: mistake.Pattern, QuestionType: mistake.QuestionType, Ending: mistake.Ending})
+		}
+
+		group := &groups[index]
+		group.Count++
+
+		if mistake.CreatedAt.After(group.LastAt) {
+			group.LastAt = mistake.CreatedAt
+		}
+
+		for _, word := range mistake.Words {
+			group.Words = appendUnique(group.Words, word)
+		}
+
+		if len(group.Answers) < maxAnswers {
+			group.Answers = appendUnique(group.Answers, mistake.Answer)
+		}
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		if groups[i].Count != groups[j].Count {
+			return groups[i].Count > groups[j].Count
+		}
+
+		return groups[i].LastAt.After(groups[j].LastAt)
+	})
+
+	return groups
+}
+
+// PickGroup chooses a group randomly, frequent patterns are more likely to be chosen
+func PickGroup(groups []Group) (Group, bool) {
+	var total int
+
+	for _, group := range groups {
+		total += group.Count
+	}
+
+	if total == 0 {
+		return Group{}, false
+	}
+
+	pick := rand.Intn(total)
+	for _, group := range groups {
+		if pick < group.Count {
+			return group, true
+		}
+
+		pick -= group.Count
+	}
+
+	return Group{}, false
+}
+
+// GetQuery returns the finder query of words matching the pattern: nouns of the same ending or the words asked before,
+// the ending is matched literally
+func (g Group) GetQuery() bson.M {
+	if g.Ending != "" {
+		return bson.M{"word.german": bson.M{"$regex": regexp.QuoteMeta(g.Ending) + "$"}}
+	}
+
+	if len(g.Words) > 0 {
+		return bson.M{"word.german": bson.M{"$in": g.Words}}
+	}
+
+	return bson.M{}
+}
+
+func appendUnique(list []string, s string) []string {
+	for _, item := range list {
+		if item == s {
+			return list
+		}
+	}
+
+	return append(list, s)
+}
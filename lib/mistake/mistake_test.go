@@ -0,0 +1,101 @@
+package mistake
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+)
+
+var mistakeStart = time.Date(2016, 10, 1, 12, 0, 0, 0, time.UTC)
+
+func TestNewMistake(t *testing.T) {
+	mistake := NewMistake("john_doe", "DerDieDas", "article", "der", []string{"die"}, 0, []string{"abc"}, makeNoun("Zeitung"), nil)
+
+	if mistake.Pattern != "wrong article for -ung nouns" || mistake.Ending != "ung" {
+		t.Fatalf("Expected the pattern of -ung nouns, got: '%s' and '%s'", mistake.Pattern, mistake.Ending)
+	}
+
+	if !reflect.DeepEqual(mistake.Words, []string{"Zeitung"}) || mistake.User != "john_doe" || mistake.CreatedAt.IsZero() {
+		t.Fatalf("Expected the mistake of john_doe for Zeitung, got: %v", mistake)
+	}
+
+	t.Log(1, "test case")
+}
+
+func TestIsMistake(t *testing.T) {
+	cases := map[int]bool{10: false, 8: false, 6: true, 0: true, -10: true}
+
+	for score, expected := range cases {
+		if IsMistake(score) != expected {
+			t.Fatalf("Expected score %d to be a mistake: %v", score, expected)
+		}
+	}
+
+	t.Log(len(cases), "test cases")
+}
+
+func TestGroupMistakes(t *testing.T) {
+	mistakes := []Mistake{
+		Mistake{Game: "DerDieDas", Pattern: "wrong article for -ung nouns", Ending: "ung", Words: []string{"Zeitung"}, Answer: "der", CreatedAt: mistakeStart},
+		Mistake{Game: "Conjugate", Pattern: "missing umlaut in present S2", Words: []string{"fahren"}, Answer: "fahrst", CreatedAt: mistakeStart.Add(time.Hour)},
+		Mistake{Game: "DerDieDas", Pattern: "wrong article for -ung nouns", Ending: "ung", Words: []string{"Wohnung"}, Answer: "der", CreatedAt: mistakeStart.Add(2 * time.Hour)},
+		Mistake{Game: "Recall", Pattern: "wrong answer", Words: []string{"Hund"}, Answer: "Katze", CreatedAt: mistakeStart.Add(3 * time.Hour)},
+		Mistake{Game: "DerDieDas", Pattern: "wrong article for -ung nouns", Ending: "ung", Words: []string{"Zeitung"}, Answer: "das", CreatedAt: mistakeStart.Add(-time.Hour)},
+	}
+
+	groups := GroupMistakes(mistakes)
+
+	expected := []Group{
+		Group{Game: "DerDieDas", Pattern: "wrong article for -ung nouns", Ending: "ung", Count: 3, Words: []string{"Zeitung", "Wohnung"}, Answers: []string{"der", "das"}, LastAt: mistakeStart.Add(2 * time.Hour)},
+		Group{Game: "Recall", Pattern: "wrong answer", Count: 1, Words: []string{"Hund"}, Answers: []string{"Katze"}, LastAt: mistakeStart.Add(3 * time.Hour)},
+		Group{Game: "Conjugate", Pattern: "missing umlaut in present S2", Count: 1, Words: []string{"fahren"}, Answers: []string{"fahrst"}, LastAt: mistakeStart.Add(time.Hour)},
+	}
+
+	if !reflect.DeepEqual(groups, expected) {
+		t.Fatalf("Expected groups: %v, got: %v", expected, groups)
+	}
+
+	t.Log(1, "test case")
+}
+
+func TestPickGroup(t *testing.T) {
+	_, ok := PickGroup([]Group{})
+	if ok {
+		t.Fatalf("Expected no group to be picked from an empty list")
+	}
+
+	groups := []Group{Group{Pattern: "never", Count: 0}, Group{Pattern: "always", Count: 3}}
+
+	for i := 0; i < 10; i++ {
+		group, ok := PickGroup(groups)
+		if !ok || group.Pattern != "always" {
+			t.Fatalf("Expected the only group with mistakes to be picked, got: %v", group)
+		}
+	}
+
+	t.Log(2, "test cases")
+}
+
+var groupQueryCases = []struct {
+	group    Group
+	expected bson.M
+}{
+	{Group{Ending: "ung", Words: []string{"Zeitung"}}, bson.M{"word.german": bson.M{"$regex": "ung$"}}},
+	{Group{Ending: "(e)n", Words: []string{"Bären"}}, bson.M{"word.german": bson.M{"$regex": `\(e\)n$`}}},
+	{Group{Words: []string{"Hund", "Katze"}}, bson.M{"word.german": bson.M{"$in": []string{"Hund", "Katze"}}}},
+	{Group{}, bson.M{}},
+}
+
+func TestGroupGetQuery(t *testing.T) {
+	for num, testCase := range groupQueryCases {
+		query := testCase.group.GetQuery()
+
+		if !reflect.DeepEqual(query, testCase.expected) {
+			t.Fatalf("Case #%d: Expected query: %v, got: %v", num+1, testCase.expected, query)
+		}
+	}
+
+	t.Log(len(groupQueryCases), "test cases")
+}
@@ -0,0 +1,192 @@
+package mistake
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/peteraba/d5/lib/german/entity"
+	"github.com/peteraba/d5/lib/german/grade"
+	"github.com/peteraba/d5/lib/util"
+)
+
+// Kinds of mistakes found by comparing the answer to the closest right answer
+const (
+	KindCapitalization = "capitalization"
+	KindMissingUmlaut  = "missing umlaut"
+	KindExtraUmlaut    = "extra umlaut"
+	KindWrongArticle   = "wrong article"
+	KindWrongAuxiliary = "wrong auxiliary"
+	KindWrongEnding    = "wrong ending"
+	KindTypo           = "typo"
+	KindWrongAnswer    = "wrong answer"
+)
+
+const (
+	// questionTypeArticle is the question type of games asking the article of a noun
+	questionTypeArticle = "article"
+	// maxEndingChange is the number of characters an answer with a wrong ending may differ in at its end
+	maxEndingChange = 3
+	// maxTypos is the distance up to which an answer is considered a typo
+	maxTypos = 2
+)
+
+var (
+	articles = []string{"der", "die", "das", "den", "dem", "des", "ein", "eine", "einen", "einem", "einer", "eines"}
+
+	auxiliaries = []string{
+		"habe", "hast", "hat", "haben", "habt", "hatte", "hattest", "hatten", "hattet",
+		"bin", "bist", "ist", "sind", "seid", "war", "warst", "waren", "wart",
+	}
+
+	// nounEndings are the endings telling the gender of nouns, longer ones first
+	nounEndings = []string{
+		"schaft", "ismus", "chen", "lein", "heit", "keit", "ment", "ling", "tum", "ung", "ion", "tät", "nis", "ei", "ik", "ur", "um", "e",
+	}
+
+	umlauts = strings.NewReplacer("ä", "a", "ö", "o", "ü", "u")
+)
+
+// Pattern is the group a mistake belongs to, ending is set if the mistake is about nouns with a given ending
+type Pattern struct {
+	Name   string
+	Ending string
+}
+
+// Classify finds the pattern of a wrong answer, the question type is added to the name unless it's the game itself
+func Classify(gameName, questionType, answer string, right []string, words ...entity.Word) Pattern {
+	kind := GetKind(answer, right)
+
+	if kind == KindWrongArticle && questionType == questionTypeArticle {
+		if ending := getNounEnding(words); ending != "" {
+			return Pattern{fmt.Sprintf("%s for -%s nouns", kind, ending), ending}
+		}
+	}
+
+	if questionType == "" || strings.EqualFold(questionType, gameName) || questionType == questionTypeArticle {
+		return Pattern{Name: kind}
+	}
+
+	return Pattern{Name: fmt.Sprintf("%s in %s", kind, questionType)}
+}
+
+// GetKind compares the answer to each right answer and returns the most specific kind of mistake found
+func GetKind(answer string, right []string) string {
+	var (
+		best     = KindWrongAnswer
+		bestRank = len(kindRanks)
+	)
+
+	for _, r := range right {
+		kind := getKind(normalize(answer), normalize(r))
+
+		for rank, k := range kindRanks {
+			if k == kind && rank < bestRank {
+				best, bestRank = kind, rank
+			}
+		}
+	}
+
+	return best
+}
+
+// kindRanks lists the kinds of mistakes from the most specific one
+var kindRanks = []string{
+	KindCapitalization,
+	KindMissingUmlaut,
+	KindExtraUmlaut,
+	KindWrongArticle,
+	KindWrongAuxiliary,
+	KindWrongEnding,
+	KindTypo,
+	KindWrongAnswer,
+}
+
+func getKind(answer, right string) string {
+	if answer == right {
+		return KindCapitalization
+	}
+
+	if umlauts.Replace(right) == answer {
+		return KindMissingUmlaut
+	}
+
+	if umlauts.Replace(answer) == right {
+		return KindExtraUmlaut
+	}
+
+	answerFirst, answerRest := splitFirst(answer)
+	rightFirst, rightRest := splitFirst(right)
+	if answerFirst != rightFirst && answerRest == rightRest {
+		if util.StringIn(rightFirst, articles) {
+			return KindWrongArticle
+		}
+
+		if util.StringIn(rightFirst, auxiliaries) {
+			return KindWrongAuxiliary
+		}
+	}
+
+	if hasWrongEnding(answer, right) {
+		return KindWrongEnding
+	}
+
+	if grade.Distance(answer, right) <= maxTypos {
+		return KindTypo
+	}
+
+	return KindWrongAnswer
+}
+
+// hasWrongEnding checks if the answer only differs from the right one in its last few characters
+func hasWrongEnding(answer, right string) bool {
+	var (
+		ra     = []rune(answer)
+		rr     = []rune(right)
+		common int
+	)
+
+	for common < len(ra) && common < len(rr) && ra[common] == rr[common] {
+		common++
+	}
+
+	if common < maxEndingChange || strings.ContainsRune(string(rr[common:])+string(ra[common:]), ' ') {
+		return false
+	}
+
+	return len(ra)-common <= maxEndingChange && len(rr)-common <= maxEndingChange
+}
+
+// getNounEnding returns the gender telling ending of the first noun asked
+func getNounEnding(words []entity.Word) string {
+	for _, word := range words {
+		if word == nil || word.GetCategory() != entity.CategoryNoun {
+			continue
+		}
+
+		german := strings.ToLower(word.GetGerman())
+		for _, ending := range nounEndings {
+			if strings.HasSuffix(german, ending) && utf8.RuneCountInString(german) > utf8.RuneCountInString(ending)+1 {
+				return ending
+			}
+		}
+
+		return ""
+	}
+
+	return ""
+}
+
+func splitFirst(text string) (string, string) {
+	parts := strings.SplitN(text, " ", 2)
+	if len(parts) < 2 {
+		return parts[0], ""
+	}
+
+	return parts[0], parts[1]
+}
+
+// normalize lowercases the text and collapses whitespace
+func normalize(text string) string {
+	return strings.ToLower(strings.Join(strings.Fields(text), " "))
+}
@@ -0,0 +1,71 @@
+package mistake
+
+import (
+	"testing"
+
+	"github.com/peteraba/d5/lib/german/entity"
+)
+
+var getKindCases = []struct {
+	answer       string
+	right        []string
+	expectedKind string
+}{
+	{"vater", []string{"Vater"}, KindCapitalization},
+	{"fahrst", []string{"fährst"}, KindMissingUmlaut},
+	{"Väter", []string{"Vater"}, KindExtraUmlaut},
+	{"die", []string{"der"}, KindWrongArticle},
+	{"den Hund", []string{"dem Hund"}, KindWrongArticle},
+	{"hat gegangen", []string{"ist gegangen"}, KindWrongAuxiliary},
+	{"schönen", []string{"schöner", "schönes"}, KindWrongEnding},
+	{"Hund", []string{"Hunde"}, KindWrongEnding},
+	{"Tich", []string{"Tisch"}, KindTypo},
+	{"Katze", []string{"Hund"}, KindWrongAnswer},
+	{"Katze", []string{}, KindWrongAnswer},
+	{"fahrst", []string{"fahren", "fährst"}, KindMissingUmlaut},
+}
+
+func TestGetKind(t *testing.T) {
+	for num, testCase := range getKindCases {
+		kind := GetKind(testCase.answer, testCase.right)
+
+		if kind != testCase.expectedKind {
+			t.Fatalf("Case #%d: Expected kind: '%s', got: '%s'", num+1, testCase.expectedKind, kind)
+		}
+	}
+
+	t.Log(len(getKindCases), "test cases")
+}
+
+func makeNoun(german string) entity.Word {
+	return &entity.Noun{DefaultWord: entity.DefaultWord{German: german, Category: entity.CategoryNoun}}
+}
+
+var classifyCases = []struct {
+	gameName     string
+	questionType string
+	answer       string
+	right        []string
+	words        []entity.Word
+	expected     Pattern
+}{
+	{"DerDieDas", "article", "der", []string{"die"}, []entity.Word{makeNoun("Zeitung")}, Pattern{"wrong article for -ung nouns", "ung"}},
+	{"DerDieDas", "article", "die", []string{"das"}, []entity.Word{makeNoun("Mädchen")}, Pattern{"wrong article for -chen nouns", "chen"}},
+	{"DerDieDas", "article", "die", []string{"der"}, []entity.Word{makeNoun("Hund")}, Pattern{Name: "wrong article"}},
+	{"ArticleCase", "dative mit", "den", []string{"dem"}, []entity.Word{makeNoun("Zeitung")}, Pattern{Name: "wrong article in dative mit"}},
+	{"Conjugate", "present S2", "fahrst", []string{"fährst"}, nil, Pattern{Name: "missing umlaut in present S2"}},
+	{"Auxiliary", "auxiliary", "hat gegangen", []string{"ist gegangen"}, nil, Pattern{Name: "wrong auxiliary"}},
+	{"Recall", "", "Katze", []string{"Hund"}, nil, Pattern{Name: "wrong answer"}},
+}
+
+func TestClassify(t *testing.T) {
+	for num, testCase := range classifyCases {
+		pattern := Classify(testCase.gameName, testCase.questionType, testCase.answer, testCase.right, testCase.words...)
+
+		if pattern != testCase.expected {
+			t.Fatalf("Case #%d: Expected pattern: %v, got: %v", num+1, testCase.expected, pattern)
+		}
+	}
+
+	t.Log(len(classifyCases), "test cases")
+}
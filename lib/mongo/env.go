@@ -9,11 +9,17 @@ const (
 	envCollectionGeneral = "D5_COLLECTION_DATA_GENERAL"
 	envCollectionGerman  = "D5_COLLECTION_DATA_GERMAN"
 	envCollectionResult  = "D5_COLLECTION_RESULT"
+	envCollectionMistake = "D5_COLLECTION_MISTAKE"
+	envCollectionSession = "D5_COLLECTION_SESSION"
+	envCollectionRoute   = "D5_COLLECTION_ROUTE"
 )
 
 const (
-	german  = "german"
-	general = "general"
+	german       = "german"
+	general      = "general"
+	mistake      = "mistake"
+	studySession = "session"
+	route        = "route"
 )
 
 func ParseDbEnvs() (string, string) {
@@ -67,3 +73,33 @@ func ParseResultCollection() string {
 
 	return collectionName
 }
+
+// ParseMistakeCollection returns the name of the collection of wrong answers, "mistake" by default
+func ParseMistakeCollection() string {
+	collectionName := os.Getenv(envCollectionMistake)
+	if collectionName == "" {
+		return mistake
+	}
+
+	return collectionName
+}
+
+// ParseSessionCollection returns the name of the collection of study sessions, "session" by default
+func ParseSessionCollection() string {
+	collectionName := os.Getenv(envCollectionSession)
+	if collectionName == "" {
+		return studySession
+	}
+
+	return collectionName
+}
+
+// ParseRouteCollection returns the name of the collection of questions routed to games, "route" by default
+func ParseRouteCollection() string {
+	collectionName := os.Getenv(envCollectionRoute)
+	if collectionName == "" {
+		return route
+	}
+
+	return collectionName
+}
@@ -6,6 +6,9 @@ import (
 	"gopkg.in/mgo.v2"
 )
 
+// routeExpiry is the time a question can be answered through the router
+const routeExpiry = 24 * time.Hour
+
 func SetResultIndexes(mgoCollection *mgo.Collection) error {
 	index := mgo.Index{
 		Key:         []string{"createdAt"},
@@ -19,3 +22,15 @@ func SetResultIndexes(mgoCollection *mgo.Collection) error {
 
 	return mgoCollection.EnsureIndex(index)
 }
+
+// SetRouteIndexes makes routes expire, questions not answered within a day can be forgotten
+func SetRouteIndexes(mgoCollection *mgo.Collection) error {
+	index := mgo.Index{
+		Key:         []string{"createdAt"},
+		Background:  true,
+		ExpireAfter: routeExpiry,
+		Name:        "expire",
+	}
+
+	return mgoCollection.EnsureIndex(index)
+}
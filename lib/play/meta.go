@@ -0,0 +1,117 @@
+package play
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Health statuses of games
+const (
+	StatusOk   = "ok"
+	StatusDown = "down"
+)
+
+// healthTimeout is the time games have to respond to health checks
+const healthTimeout = 2 * time.Second
+
+// Option is a query parameter supported by a game, values lists the accepted ones if the list is limited
+type Option struct {
+	Name        string   `json:"name" bson:"name"`
+	Description string   `json:"description" bson:"description"`
+	Values      []string `json:"values,omitempty" bson:"values,omitempty"`
+	Default     string   `json:"default,omitempty" bson:"default,omitempty"`
+}
+
+// Meta describes a game, users need words of each category required, games without categories can be played with any
+// words, unless they require no words at all
+type Meta struct {
+	Name          string   `json:"name"`
+	Description   string   `json:"description"`
+	Categories    []string `json:"categories,omitempty"`
+	RequiresWords bool     `json:"requiresWords"`
+	Options       []Option `json:"options,omitempty"`
+	Version       string   `json:"version"`
+	Url           string   `json:"url,omitempty"`
+}
+
+// Health is the status of a game as reported by itself
+type Health struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Status  string `json:"status"`
+	Error   string `json:"error,omitempty"`
+}
+
+// IsPlayable checks if a dictionary has the words needed for a game, counts are the number of words by category
+func (m Meta) IsPlayable(counts map[string]int) bool {
+	var total int
+
+	for _, count := range counts {
+		total += count
+	}
+
+	if !m.RequiresWords {
+		return true
+	}
+
+	if total == 0 {
+		return false
+	}
+
+	for _, category := range m.Categories {
+		if counts[category] == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Register sends the description of a game to admin, so that it knows where the game can be reached
+func Register(adminUrl string, meta Meta) error {
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.Post(strings.TrimRight(adminUrl, "/")+"/game/register", "application/json", bytes.NewReader(data))
+	if err != nil {
+		return errors.New(fmt.Sprintf("Calling admin failed: %v", err))
+	}
+
+	_, _, err = readResponse(resp)
+
+	return err
+}
+
+// CheckHealth asks a game for its health, games not responding in time are down
+func CheckHealth(gameUrl string) Health {
+	var health Health
+
+	client := http.Client{Timeout: healthTimeout}
+
+	resp, err := client.Get(strings.TrimRight(gameUrl, "/") + "/health")
+	if err != nil {
+		return Health{Status: StatusDown, Error: fmt.Sprint(err)}
+	}
+
+	body, _, err := readResponse(resp)
+	if jsonErr := json.Unmarshal(body, &health); err == nil {
+		err = jsonErr
+	}
+
+	if err != nil && health.Error == "" {
+		health.Error = fmt.Sprint(err)
+	}
+
+	if err != nil || health.Status != StatusOk {
+		health.Status = StatusDown
+	}
+
+	return health
+}
@@ -0,0 +1,80 @@
+package play
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIsPlayable(t *testing.T) {
+	cases := []struct {
+		meta       Meta
+		counts     map[string]int
+		isPlayable bool
+	}{
+		{Meta{Name: "Numbers"}, map[string]int{}, true},
+		{Meta{Name: "Recall", RequiresWords: true}, map[string]int{}, false},
+		{Meta{Name: "Recall", RequiresWords: true}, map[string]int{"adv": 1}, true},
+		{Meta{Name: "DerDieDas", RequiresWords: true, Categories: []string{"noun"}}, map[string]int{"verb": 12}, false},
+		{Meta{Name: "DerDieDas", RequiresWords: true, Categories: []string{"noun"}}, map[string]int{"noun": 3, "verb": 12}, true},
+		{Meta{Name: "DeclineAdjective", RequiresWords: true, Categories: []string{"adj", "noun"}}, map[string]int{"noun": 3}, false},
+		{Meta{Name: "DeclineAdjective", RequiresWords: true, Categories: []string{"adj", "noun"}}, map[string]int{"noun": 3, "adj": 1}, true},
+	}
+
+	for num, testCase := range cases {
+		isPlayable := testCase.meta.IsPlayable(testCase.counts)
+
+		if isPlayable != testCase.isPlayable {
+			t.Fatalf("Test case %d failed. Expected playable: %v, got: %v", num, testCase.isPlayable, isPlayable)
+		}
+	}
+
+	t.Log(len(cases), "test cases")
+}
+
+func TestRegisterAndCheckHealth(t *testing.T) {
+	var registered Meta
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/game/register":
+			json.NewDecoder(r.Body).Decode(&registered)
+			fmt.Fprint(w, `{"status":"OK"}`)
+			break
+		case "/health":
+			fmt.Fprint(w, `{"name":"DerDieDas","version":"0.1","status":"ok"}`)
+			break
+		case "/sick/health":
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(w, `{"name":"Recall","version":"0.1","status":"down","error":"no reachable servers"}`)
+			break
+		default:
+			http.Error(w, "not found", http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	err := Register(server.URL+"/", Meta{Name: "DerDieDas", Version: "0.1", Url: "http://localhost:10410/"})
+	if err != nil || registered.Name != "DerDieDas" || registered.Url != "http://localhost:10410/" {
+		t.Fatalf("Unexpected registration: %v, %v", registered, err)
+	}
+
+	health := CheckHealth(server.URL)
+	if health.Status != StatusOk || health.Name != "DerDieDas" {
+		t.Fatalf("Expected game to be healthy, got: %v", health)
+	}
+
+	health = CheckHealth(server.URL + "/sick")
+	if health.Status != StatusDown || health.Name != "Recall" || health.Error == "" {
+		t.Fatalf("Expected game to be down, got: %v", health)
+	}
+
+	health = CheckHealth(server.URL + "/missing")
+	if health.Status != StatusDown {
+		t.Fatalf("Expected missing game to be down, got: %v", health)
+	}
+
+	t.Log(4, "test cases")
+}
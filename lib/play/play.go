@@ -0,0 +1,96 @@
+package play
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Choice is a game a user can play, games are chosen proportionally to their weight
+type Choice struct {
+	Name   string `json:"name"`
+	Url    string `json:"url"`
+	Weight int    `json:"weight"`
+}
+
+// Pick chooses a game randomly by weight, games without a positive weight are never chosen
+func Pick(choices []Choice) (Choice, bool) {
+	var total int
+
+	for _, choice := range choices {
+		if choice.Weight > 0 {
+			total += choice.Weight
+		}
+	}
+
+	if total == 0 {
+		return Choice{}, false
+	}
+
+	pick := rand.Intn(total)
+	for _, choice := range choices {
+		if choice.Weight <= 0 {
+			continue
+		}
+
+		if pick < choice.Weight {
+			return choice, true
+		}
+
+		pick -= choice.Weight
+	}
+
+	return Choice{}, false
+}
+
+// GetQuestion asks a game for a new question for the user, params are passed on as query parameters (e.g. lang)
+func GetQuestion(gameUrl, user string, params url.Values) ([]byte, int, error) {
+	resp, err := http.Get(makeUrl(gameUrl, "game", user, params))
+	if err != nil {
+		return nil, http.StatusServiceUnavailable, errors.New(fmt.Sprintf("Calling game failed: %v", err))
+	}
+
+	return readResponse(resp)
+}
+
+// PostAnswer sends the answer of a question to the game that asked it
+func PostAnswer(gameUrl, user string, params url.Values, id, answer string) ([]byte, int, error) {
+	data := url.Values{}
+	data.Set("id", id)
+	data.Set("answer", answer)
+
+	resp, err := http.PostForm(makeUrl(gameUrl, "answer", user, params), data)
+	if err != nil {
+		return nil, http.StatusServiceUnavailable, errors.New(fmt.Sprintf("Calling game failed: %v", err))
+	}
+
+	return readResponse(resp)
+}
+
+func makeUrl(gameUrl, action, user string, params url.Values) string {
+	u := fmt.Sprintf("%s/%s/%s", strings.TrimRight(gameUrl, "/"), action, url.PathEscape(user))
+	if len(params) > 0 {
+		u += "?" + params.Encode()
+	}
+
+	return u
+}
+
+func readResponse(resp *http.Response) ([]byte, int, error) {
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, http.StatusInternalServerError, errors.New(fmt.Sprintf("Reading game response failed: %v", err))
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return body, resp.StatusCode, errors.New(fmt.Sprintf("Game responded with status %d: %s", resp.StatusCode, string(body)))
+	}
+
+	return body, http.StatusOK, nil
+}
@@ -0,0 +1,72 @@
+package play
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestPick(t *testing.T) {
+	_, ok := Pick([]Choice{Choice{Name: "Recall", Weight: 0}, Choice{Name: "Plural", Weight: -1}})
+	if ok {
+		t.Fatalf("Expected no game to be picked without positive weights")
+	}
+
+	counts := map[string]int{}
+	choices := []Choice{Choice{Name: "Recall", Weight: 3}, Choice{Name: "Plural", Weight: 0}, Choice{Name: "DerDieDas", Weight: 1}}
+
+	for i := 0; i < 400; i++ {
+		choice, ok := Pick(choices)
+		if !ok {
+			t.Fatalf("Expected a game to be picked")
+		}
+
+		counts[choice.Name]++
+	}
+
+	if counts["Plural"] != 0 || counts["Recall"] <= counts["DerDieDas"] {
+		t.Fatalf("Expected games to be picked by weight, got: %v", counts)
+	}
+
+	t.Log(2, "test cases")
+}
+
+func TestGetQuestionAndPostAnswer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+
+		if r.URL.Path == "/answer/john doe" {
+			fmt.Fprintf(w, `{"score":%s}`, r.PostForm.Get("answer"))
+
+			return
+		}
+
+		if r.URL.Path != "/game/john doe" {
+			http.Error(w, "not found", http.StatusNotFound)
+
+			return
+		}
+
+		fmt.Fprintf(w, `{"question":"%s","id":"abc"}`, r.URL.Query().Get("lang"))
+	}))
+	defer server.Close()
+
+	body, code, err := GetQuestion(server.URL+"/", "john doe", url.Values{"lang": []string{"hu"}})
+	if err != nil || code != http.StatusOK || string(body) != `{"question":"hu","id":"abc"}` {
+		t.Fatalf("Unexpected question: %s, %d, %v", body, code, err)
+	}
+
+	body, code, err = PostAnswer(server.URL, "john doe", nil, "abc", "10")
+	if err != nil || code != http.StatusOK || string(body) != `{"score":10}` {
+		t.Fatalf("Unexpected answer result: %s, %d, %v", body, code, err)
+	}
+
+	_, code, err = GetQuestion(server.URL+"/missing", "john doe", nil)
+	if err == nil || code != http.StatusNotFound {
+		t.Fatalf("Expected an error with status 404, got: %d, %v", code, err)
+	}
+
+	t.Log(3, "test cases")
+}
@@ -0,0 +1,81 @@
+package play
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/peteraba/d5/lib/mongo"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// Route is a question asked by a game, answers are sent back to the game by the id of the question
+type Route struct {
+	Id        string    `json:"id" bson:"_id"`
+	Game      string    `json:"game" bson:"game"`
+	User      string    `json:"user" bson:"user"`
+	CreatedAt time.Time `json:"createdAt" bson:"createdAt"`
+}
+
+// GetQuestionId returns the id of a question received from a game, answers have to be sent with this id
+func GetQuestionId(body []byte) (string, error) {
+	var question struct {
+		Id string `json:"id"`
+	}
+
+	err := json.Unmarshal(body, &question)
+	if err != nil {
+		return "", errors.New(fmt.Sprintf("Invalid question: %v", err))
+	}
+
+	if question.Id == "" {
+		return "", errors.New("Question has no id.")
+	}
+
+	return question.Id, nil
+}
+
+// GetQuestionError returns the error sent by a game instead of a question, e.g. if no words are found for the user
+func GetQuestionError(body []byte) string {
+	var question struct {
+		Id    string `json:"id"`
+		Error string `json:"error"`
+	}
+
+	err := json.Unmarshal(body, &question)
+	if err != nil || question.Id != "" {
+		return ""
+	}
+
+	return question.Error
+}
+
+// GetRouteCollection returns the collection of routes of a database
+func GetRouteCollection(mgoDb *mgo.Database) *mgo.Collection {
+	return mgoDb.C(mongo.ParseRouteCollection())
+}
+
+// SaveRoute stores the game a question was asked by
+func SaveRoute(mgoCollection *mgo.Collection, route Route) error {
+	_, err := mgoCollection.UpsertId(route.Id, route)
+
+	return err
+}
+
+// FindRoute returns the game a question was asked by
+func FindRoute(mgoCollection *mgo.Collection, id string) (Route, error) {
+	var routes []Route
+
+	err := mgoCollection.Find(bson.M{"_id": id}).All(&routes)
+	if err != nil {
+		return Route{}, err
+	}
+
+	if len(routes) < 1 {
+		return Route{}, errors.New(fmt.Sprintf("Question not found: %s", id))
+	}
+
+	return routes[0], nil
+}
@@ -0,0 +1,52 @@
+package play
+
+import "testing"
+
+func TestGetQuestionId(t *testing.T) {
+	cases := []struct {
+		body    string
+		id      string
+		isValid bool
+	}{
+		{`{"question":"Haus","options":["der","die","das"],"id":"5a1c5b2f288a2139ea45f530"}`, "5a1c5b2f288a2139ea45f530", true},
+		{`{"question":"Haus"}`, "", false},
+		{`{"id":""}`, "", false},
+		{`Game over`, "", false},
+	}
+
+	for num, testCase := range cases {
+		id, err := GetQuestionId([]byte(testCase.body))
+
+		if testCase.isValid != (err == nil) {
+			t.Fatalf("Test case %d failed. Expected valid: %v, got error: %v", num, testCase.isValid, err)
+		}
+
+		if id != testCase.id {
+			t.Fatalf("Test case %d failed. Expected id: %s, got: %s", num, testCase.id, id)
+		}
+	}
+
+	t.Log(len(cases), "test cases")
+}
+
+func TestGetQuestionError(t *testing.T) {
+	cases := []struct {
+		body     string
+		expected string
+	}{
+		{`{"error":"No words found"}`, "No words found"},
+		{`{"question":"Haus","id":"abc","error":"Saving answer failed"}`, ""},
+		{`{"question":"Haus","id":"abc"}`, ""},
+		{`Game over`, ""},
+	}
+
+	for num, testCase := range cases {
+		actual := GetQuestionError([]byte(testCase.body))
+
+		if actual != testCase.expected {
+			t.Fatalf("Test case %d failed. Expected error: %s, got: %s", num, testCase.expected, actual)
+		}
+	}
+
+	t.Log(len(cases), "test cases")
+}
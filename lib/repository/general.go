@@ -3,6 +3,7 @@ package repository
 import (
 	"log"
 	"sort"
+	"time"
 
 	"gopkg.in/mgo.v2"
 	"gopkg.in/mgo.v2/bson"
@@ -39,25 +40,31 @@ func (r *Repo) FetchWord(collectionName string, objectId bson.ObjectId) (entity.
 	var (
 		err        error
 		collection *mgo.Collection
-		superwords = []german.Superword{}
+		documents  = []bson.Raw{}
+		words      []entity.Word
 	)
 
 	collection = r.Db.C(collectionName)
 
-	err = collection.FindId(objectId).All(&superwords)
+	err = collection.FindId(objectId).All(&documents)
 	if err != nil {
 		log.Printf("Error while finding word with id: %v, err: %v\n", objectId, err)
 
 		return nil, err
 	}
 
-	if len(superwords) < 1 {
+	if len(documents) < 1 {
 		log.Printf("Failed to find word with id: %v\n", objectId)
 
 		return nil, nil
 	}
 
-	words := german.SuperwordsToWords(superwords)
+	words, err = german.DecodeBSONWords(documents)
+	if err != nil {
+		log.Printf("Error while decoding word with id: %v, err: %v\n", objectId, err)
+
+		return nil, err
+	}
 
 	return words[0], nil
 }
@@ -80,6 +87,47 @@ func (r *Repo) UpdateWord(collectionName string, objectId bson.ObjectId, data in
 	return nil
 }
 
+// SortByDue orders words due for review first, the most overdue one first, then the words never reviewed and finally the ones scheduled for later
+func SortByDue(words []entity.Word, now time.Time) []entity.Word {
+	var (
+		due       = []entity.Word{}
+		fresh     = []entity.Word{}
+		scheduled = []entity.Word{}
+	)
+
+	for _, word := range words {
+		schedule := word.GetSchedule()
+
+		if schedule.IsNew() {
+			fresh = append(fresh, word)
+		} else if schedule.IsDue(now) {
+			due = append(due, word)
+		} else {
+			scheduled = append(scheduled, word)
+		}
+	}
+
+	sort.Stable(byDueDate(due))
+	sort.Sort(ByLearned(fresh))
+	sort.Stable(byDueDate(scheduled))
+
+	return append(append(due, fresh...), scheduled...)
+}
+
+type byDueDate []entity.Word
+
+func (a byDueDate) Len() int {
+	return len(a)
+}
+
+func (a byDueDate) Swap(i, j int) {
+	a[i], a[j] = a[j], a[i]
+}
+
+func (a byDueDate) Less(i, j int) bool {
+	return a[i].GetSchedule().Due.Before(a[j].GetSchedule().Due)
+}
+
 func getScore(w entity.Word) int64 {
 	var score int64
 
@@ -90,38 +138,38 @@ func getScore(w entity.Word) int64 {
 	return score
 }
 
-func (r *Repo) fetchCollection(collectionName string, query bson.M) ([]german.Superword, error) {
+func (r *Repo) fetchCollection(collectionName string, query bson.M) ([]entity.Word, error) {
 	var (
 		collection *mgo.Collection
 		err        error
-		result     = []german.Superword{}
+		documents  = []bson.Raw{}
 	)
 
 	collection = r.Db.C(collectionName)
 
-	err = collection.Find(query).All(&result)
+	err = collection.Find(query).All(&documents)
+	if err != nil {
+		return []entity.Word{}, err
+	}
 
-	return result, err
+	return german.DecodeBSONWords(documents)
 }
 
 func (r *Repo) FetchDictionary(collectionName string, query bson.M) (interface{}, error) {
 	var (
-		err          error
-		searchResult []german.Superword
+		err error
 	)
 
-	searchResult, err = r.fetchCollection(collectionName, query)
+	r.lastResult, err = r.fetchCollection(collectionName, query)
 	if err != nil {
 		return []entity.Word{}, err
 	}
 
-	r.lastResult = german.SuperwordsToWords(searchResult)
-
 	return r.lastResult, err
 }
 
 func (r *Repo) FilterDictionary(limit int) (interface{}, error) {
-	sort.Sort(ByLearned(r.lastResult))
+	r.lastResult = SortByDue(r.lastResult, time.Now())
 
 	if limit > 0 && limit < len(r.lastResult) {
 		r.lastResult = r.lastResult[:limit]
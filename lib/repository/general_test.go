@@ -1 +1,44 @@
 package repository
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/peteraba/d5/lib/general"
+	"github.com/peteraba/d5/lib/german/entity"
+)
+
+func newScheduledWord(german string, due time.Time) entity.Word {
+	word := entity.NewAdjective(german, map[string]string{entity.LangEnglish: german}, "peter", "2016-10-01", "5", "")
+	if !due.IsZero() {
+		word.SetSchedule(general.Schedule{Interval: 1, Due: due})
+	}
+
+	return word
+}
+
+func TestSortByDue(t *testing.T) {
+	var (
+		now   = time.Date(2016, 11, 1, 12, 0, 0, 0, time.UTC)
+		words = []entity.Word{
+			newScheduledWord("later", now.Add(48*time.Hour)),
+			newScheduledWord("fresh", time.Time{}),
+			newScheduledWord("due", now.Add(-time.Hour)),
+			newScheduledWord("soon", now.Add(time.Hour)),
+			newScheduledWord("overdue", now.Add(-72*time.Hour)),
+		}
+		expected = "overdue,due,fresh,soon,later"
+	)
+
+	sorted := SortByDue(words, now)
+
+	actual := []string{}
+	for _, word := range sorted {
+		actual = append(actual, word.GetGerman())
+	}
+
+	if strings.Join(actual, ",") != expected {
+		t.Fatalf("Words are sorted wrong. Expected: '%s', got: '%s'.", expected, strings.Join(actual, ","))
+	}
+}
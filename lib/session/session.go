@@ -0,0 +1,237 @@
+package session
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/peteraba/d5/lib/mistake"
+	"github.com/peteraba/d5/lib/mongo"
+	"github.com/peteraba/d5/lib/util"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// States of a session: questions are only asked during focus, the break has to be over before the next session
+const (
+	StateFocus    = "focus"
+	StateBreak    = "break"
+	StateFinished = "finished"
+)
+
+const (
+	// DefaultFocus is the length of the focus part of a session in minutes
+	DefaultFocus = 25
+	// DefaultBreak is the length of the break after a session in minutes
+	DefaultBreak = 5
+)
+
+// Question is a question asked in a session, waiting for an answer
+type Question struct {
+	Game    string    `json:"game" bson:"game"`
+	Id      string    `json:"id" bson:"id"`
+	AskedAt time.Time `json:"askedAt" bson:"askedAt"`
+}
+
+// Answer is an answer given in a session, new words were never scored before the answer
+type Answer struct {
+	Game       string    `json:"game" bson:"game"`
+	QuestionId string    `json:"questionId" bson:"questionId"`
+	Answer     string    `json:"answer" bson:"answer"`
+	Score      int       `json:"score" bson:"score"`
+	WordIds    []string  `json:"wordIds,omitempty" bson:"wordIds,omitempty"`
+	NewWordIds []string  `json:"newWordIds,omitempty" bson:"newWordIds,omitempty"`
+	AnsweredAt time.Time `json:"answeredAt" bson:"answeredAt"`
+}
+
+// Session is a timed study session of a user, a focus period followed by a break
+type Session struct {
+	Id           bson.ObjectId `json:"id" bson:"_id"`
+	User         string        `json:"user" bson:"user"`
+	Language     string        `json:"language,omitempty" bson:"language,omitempty"`
+	FocusMinutes int           `json:"focusMinutes" bson:"focusMinutes"`
+	BreakMinutes int           `json:"breakMinutes" bson:"breakMinutes"`
+	StartedAt    time.Time     `json:"startedAt" bson:"startedAt"`
+	Questions    []Question    `json:"questions,omitempty" bson:"questions,omitempty"`
+	Answers      []Answer      `json:"answers,omitempty" bson:"answers,omitempty"`
+}
+
+// Summary is the outcome of a session so far
+type Summary struct {
+	State       string         `json:"state"`
+	FocusEnd    time.Time      `json:"focusEnd"`
+	BreakEnd    time.Time      `json:"breakEnd"`
+	Answered    int            `json:"answered"`
+	Right       int            `json:"right"`
+	Accuracy    float64        `json:"accuracy"`
+	Words       []string       `json:"words,omitempty"`
+	NewWords    int            `json:"newWords"`
+	ReviewWords int            `json:"reviewWords"`
+	Games       map[string]int `json:"games,omitempty"`
+}
+
+// NewSession creates a session starting now, lengths are given in minutes
+func NewSession(user, language string, focusMinutes, breakMinutes int, now time.Time) Session {
+	return Session{
+		Id:           bson.NewObjectId(),
+		User:         user,
+		Language:     language,
+		FocusMinutes: focusMinutes,
+		BreakMinutes: breakMinutes,
+		StartedAt:    now,
+	}
+}
+
+// GetFocusEnd returns the time questions stop being asked
+func (s Session) GetFocusEnd() time.Time {
+	return s.StartedAt.Add(time.Duration(s.FocusMinutes) * time.Minute)
+}
+
+// GetBreakEnd returns the time the next session can be started
+func (s Session) GetBreakEnd() time.Time {
+	return s.GetFocusEnd().Add(time.Duration(s.BreakMinutes) * time.Minute)
+}
+
+// GetState returns the state of the session at a given time
+func (s Session) GetState(now time.Time) string {
+	if now.Before(s.GetFocusEnd()) {
+		return StateFocus
+	}
+
+	if now.Before(s.GetBreakEnd()) {
+		return StateBreak
+	}
+
+	return StateFinished
+}
+
+// CanStart checks if a new session can be started after the previous one of the user, the break has to be over
+func CanStart(previous *Session, now time.Time) bool {
+	return previous == nil || !now.Before(previous.GetBreakEnd())
+}
+
+// AddQuestion records a question asked, questions can only be asked during focus
+func (s *Session) AddQuestion(gameName, id string, now time.Time) error {
+	if state := s.GetState(now); state != StateFocus {
+		return errors.New(fmt.Sprintf("No questions are asked in %s, the focus ended at %s.", state, s.GetFocusEnd().Format(time.RFC3339)))
+	}
+
+	s.Questions = append(s.Questions, Question{gameName, id, now})
+
+	return nil
+}
+
+// FindQuestion returns a question waiting for an answer
+func (s Session) FindQuestion(id string) (Question, bool) {
+	for _, question := range s.Questions {
+		if question.Id == id {
+			return question, true
+		}
+	}
+
+	return Question{}, false
+}
+
+// AddAnswer records the answer of a question asked, questions asked during focus can be answered in the break too
+func (s *Session) AddAnswer(answer Answer) error {
+	for i, question := range s.Questions {
+		if question.Id != answer.QuestionId {
+			continue
+		}
+
+		if s.GetState(answer.AnsweredAt) == StateFinished {
+			return errors.New("Session is finished.")
+		}
+
+		answer.Game = question.Game
+		s.Questions = append(s.Questions[:i], s.Questions[i+1:]...)
+		s.Answers = append(s.Answers, answer)
+
+		return nil
+	}
+
+	return errors.New(fmt.Sprintf("Question was not asked in this session: %s", answer.QuestionId))
+}
+
+// Summarize counts the answers, the right ones and the words practised, words are new if they were new at their first answer
+func (s Session) Summarize(now time.Time) Summary {
+	var (
+		summary = Summary{State: s.GetState(now), FocusEnd: s.GetFocusEnd(), BreakEnd: s.GetBreakEnd(), Games: map[string]int{}}
+		seen    = map[string]bool{}
+	)
+
+	for _, answer := range s.Answers {
+		summary.Answered++
+		summary.Games[answer.Game]++
+
+		if !mistake.IsMistake(answer.Score) {
+			summary.Right++
+		}
+
+		for _, wordId := range answer.WordIds {
+			if seen[wordId] {
+				continue
+			}
+
+			seen[wordId] = true
+			summary.Words = append(summary.Words, wordId)
+
+			if util.StringIn(wordId, answer.NewWordIds) {
+				summary.NewWords++
+			} else {
+				summary.ReviewWords++
+			}
+		}
+	}
+
+	if summary.Answered > 0 {
+		summary.Accuracy = float64(summary.Right) / float64(summary.Answered)
+	}
+
+	return summary
+}
+
+/**
+ * REPOSITORY
+ */
+
+// GetCollection returns the collection of sessions of a database
+func GetCollection(mgoDb *mgo.Database) *mgo.Collection {
+	return mgoDb.C(mongo.ParseSessionCollection())
+}
+
+// Save stores a new session or the changes of an existing one
+func Save(mgoCollection *mgo.Collection, s Session) error {
+	_, err := mgoCollection.UpsertId(s.Id, s)
+
+	return err
+}
+
+// Find returns a session by its id
+func Find(mgoCollection *mgo.Collection, id string) (Session, error) {
+	var sessions []Session
+
+	if !bson.IsObjectIdHex(id) {
+		return Session{}, errors.New(fmt.Sprintf("Invalid session id: %s", id))
+	}
+
+	err := mgoCollection.FindId(bson.ObjectIdHex(id)).All(&sessions)
+	if err != nil {
+		return Session{}, err
+	}
+
+	if len(sessions) < 1 {
+		return Session{}, errors.New(fmt.Sprintf("Session not found: %s", id))
+	}
+
+	return sessions[0], nil
+}
+
+// FindHistory returns the sessions of a user, the latest one first
+func FindHistory(mgoCollection *mgo.Collection, user string, limit int) ([]Session, error) {
+	sessions := []Session{}
+
+	err := mgoCollection.Find(bson.M{"user": user}).Sort("-startedAt").Limit(limit).All(&sessions)
+
+	return sessions, err
+}
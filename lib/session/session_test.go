@@ -0,0 +1,130 @@
+package session
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+var sessionStart = time.Date(2016, 10, 1, 12, 0, 0, 0, time.UTC)
+
+var stateCases = []struct {
+	minutes       int
+	expectedState string
+}{
+	{0, StateFocus},
+	{24, StateFocus},
+	{25, StateBreak},
+	{29, StateBreak},
+	{30, StateFinished},
+}
+
+func TestGetState(t *testing.T) {
+	s := NewSession("john_doe", "en", DefaultFocus, DefaultBreak, sessionStart)
+
+	for num, testCase := range stateCases {
+		state := s.GetState(sessionStart.Add(time.Duration(testCase.minutes) * time.Minute))
+
+		if state != testCase.expectedState {
+			t.Fatalf("Case #%d: Expected state: %s, got: %s", num+1, testCase.expectedState, state)
+		}
+	}
+
+	t.Log(len(stateCases), "test cases")
+}
+
+func TestCanStart(t *testing.T) {
+	previous := NewSession("john_doe", "en", 10, 5, sessionStart)
+
+	if !CanStart(nil, sessionStart) {
+		t.Fatalf("Expected the first session to be startable")
+	}
+
+	if CanStart(&previous, sessionStart.Add(12*time.Minute)) {
+		t.Fatalf("Expected no session to be started during the break")
+	}
+
+	if !CanStart(&previous, sessionStart.Add(15*time.Minute)) {
+		t.Fatalf("Expected a session to be startable after the break")
+	}
+
+	t.Log(3, "test cases")
+}
+
+func TestQuestionsAreOnlyAskedDuringFocus(t *testing.T) {
+	s := NewSession("john_doe", "en", 10, 5, sessionStart)
+
+	err := s.AddQuestion("Recall", "q1", sessionStart.Add(9*time.Minute))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	err = s.AddQuestion("Recall", "q2", sessionStart.Add(10*time.Minute))
+	if err == nil {
+		t.Fatalf("Expected no question to be asked in the break")
+	}
+
+	if _, ok := s.FindQuestion("q1"); !ok {
+		t.Fatalf("Expected question to be found")
+	}
+
+	t.Log(3, "test cases")
+}
+
+func TestAddAnswer(t *testing.T) {
+	s := NewSession("john_doe", "en", 10, 5, sessionStart)
+	s.AddQuestion("Recall", "q1", sessionStart)
+	s.AddQuestion("Plural", "q2", sessionStart)
+
+	err := s.AddAnswer(Answer{QuestionId: "q3", AnsweredAt: sessionStart})
+	if err == nil {
+		t.Fatalf("Expected an error for a question not asked")
+	}
+
+	err = s.AddAnswer(Answer{QuestionId: "q1", Score: 10, AnsweredAt: sessionStart.Add(11 * time.Minute)})
+	if err != nil {
+		t.Fatalf("Expected questions to be answered in the break, got: %v", err)
+	}
+
+	if _, ok := s.FindQuestion("q1"); ok || len(s.Answers) != 1 || s.Answers[0].Game != "Recall" {
+		t.Fatalf("Expected the question to be answered, got: %v", s)
+	}
+
+	err = s.AddAnswer(Answer{QuestionId: "q2", AnsweredAt: sessionStart.Add(15 * time.Minute)})
+	if err == nil {
+		t.Fatalf("Expected no answer to be accepted after the break")
+	}
+
+	t.Log(3, "test cases")
+}
+
+func TestSummarize(t *testing.T) {
+	s := NewSession("john_doe", "en", DefaultFocus, DefaultBreak, sessionStart)
+	s.Answers = []Answer{
+		Answer{Game: "Recall", Score: 10, WordIds: []string{"a"}, NewWordIds: []string{"a"}},
+		Answer{Game: "Plural", Score: 0, WordIds: []string{"b"}},
+		Answer{Game: "Recall", Score: 8, WordIds: []string{"a", "c"}, NewWordIds: []string{"c"}},
+		Answer{Game: "Numbers", Score: 3},
+	}
+
+	summary := s.Summarize(sessionStart.Add(26 * time.Minute))
+
+	expected := Summary{
+		State:       StateBreak,
+		FocusEnd:    sessionStart.Add(25 * time.Minute),
+		BreakEnd:    sessionStart.Add(30 * time.Minute),
+		Answered:    4,
+		Right:       2,
+		Accuracy:    0.5,
+		Words:       []string{"a", "b", "c"},
+		NewWords:    2,
+		ReviewWords: 1,
+		Games:       map[string]int{"Recall": 2, "Plural": 1, "Numbers": 1},
+	}
+
+	if !reflect.DeepEqual(summary, expected) {
+		t.Fatalf("Expected summary: %v, got: %v", expected, summary)
+	}
+
+	t.Log(1, "test case")
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/peteraba/d5/lib/migration"
+	"github.com/peteraba/d5/lib/mongo"
+	"github.com/peteraba/d5/lib/util"
+	mgo "gopkg.in/mgo.v2"
+)
+
+const name = "migrator"
+const version = "0.1"
+const usage = `
+Migrator upgrades stored documents to the latest schema.
+
+Migrations already applied are tracked in the migration collection and are skipped.
+
+Usage:
+  migrator [--debug] [--list] [--third=<s>]
+  migrator -h | --help
+  migrator -v | --version
+
+Options:
+  -d, --debug      log the number of documents updated by each migration
+  -l, --list       list known migrations without applying them
+  -t, --third=<s>  language code of the legacy third meaning column (e.g. hu)
+  -v, --version    show version information
+  -h, --help       show help information
+
+Environment variables:
+  - D5_DB_HOST                  database host or ip
+  - D5_DB_NAME                  database name
+  - D5_GAME_TYPE                game type
+  - D5_COLLECTION_DATA_GENERAL  name of general collection
+  - D5_COLLECTION_DATA_GERMAN   name of german collection
+`
+
+/**
+ * MAIN
+ */
+
+func main() {
+	cliArguments := util.GetCliArguments(usage, name, version)
+	_, _, isDebug := util.GetServerOptions(cliArguments)
+	isList, _ := cliArguments["--list"].(bool)
+	thirdLanguage, _ := cliArguments["--third"].(string)
+
+	if isList {
+		listMigrations()
+		return
+	}
+
+	mgoDb := mongo.CreateMgoDbFromEnvs()
+
+	serveCli(mgoDb, migration.Options{ThirdLanguage: thirdLanguage}, isDebug)
+}
+
+/**
+ * CLI
+ */
+
+func listMigrations() {
+	for _, m := range migration.GetMigrations() {
+		fmt.Println(m.Name)
+	}
+}
+
+func serveCli(mgoDb *mgo.Database, options migration.Options, isDebug bool) {
+	applied, err := migration.Run(mgoDb, mongo.ParseDataCollection(), options, isDebug)
+
+	util.LogFatalErr(err, true)
+
+	result, err := util.DataToJson(applied, isDebug)
+
+	util.LogFatalErr(err, isDebug)
+
+	fmt.Print(result)
+}
@@ -6,9 +6,12 @@ import (
 	"io/ioutil"
 	"log"
 	"net/http"
+	"sort"
+	"strings"
 
 	"gopkg.in/mgo.v2"
 
+	germanLib "github.com/peteraba/d5/lib/german"
 	germanEntity "github.com/peteraba/d5/lib/german/entity"
 	"github.com/peteraba/d5/lib/server"
 	"github.com/peteraba/d5/lib/util"
@@ -35,7 +38,13 @@ Options:
   -u, --user=<s>  user the data belongs to (cli mode only)
 
 Accepted input data:
-  - Raw JSON data to parse
+  - Raw JSON data to parse, the first row is the header
+
+Columns:
+  - article / auxiliary, german, english, third, category, date, score, tags
+  - any further column with a language as header (e.g. es, polish) is imported as meanings in that language
+  - any further column with examples as header is imported as example sentences (e.g. Er geht. = He goes. | Ich gehe.)
+  - any further column with notes as header is imported as notes / mnemonics
 `
 
 /**
@@ -68,14 +77,88 @@ func logParseErrors(isDebug bool, parseErrors []string) {
 	}
 }
 
-func parseDictionary(dictionary [][8]string, user string) ([]germanEntity.Word, []string) {
+const (
+	colArticleOrAuxiliary = 0
+	colGerman             = 1
+	colEnglish            = 2
+	colThird              = 3
+	colCategory           = 4
+	colLearned            = 5
+	colScore              = 6
+	colTags               = 7
+	colCount              = 8
+)
+
+const (
+	headerExamples = "examples"
+	headerNotes    = "notes"
+)
+
+var contextHeaders = map[string]string{
+	"examples":  headerExamples,
+	"example":   headerExamples,
+	"sentences": headerExamples,
+	"notes":     headerNotes,
+	"note":      headerNotes,
+	"mnemonics": headerNotes,
+}
+
+// parseHeader finds the language of each meaning column and the example / notes columns, the english column defaults to English
+func parseHeader(header []string) (map[int]string, map[int]string, []string) {
+	var (
+		languages   = map[int]string{}
+		contexts    = map[int]string{}
+		parseErrors = []string{}
+	)
+
+	for idx, name := range header {
+		if idx != colEnglish && idx != colThird && idx < colCount {
+			continue
+		}
+
+		if context, ok := contextHeaders[strings.ToLower(strings.Trim(name, " "))]; ok && idx >= colCount {
+			contexts[idx] = context
+
+			continue
+		}
+
+		lang, ok := germanEntity.NewLanguage(name)
+		if ok {
+			languages[idx] = lang
+
+			continue
+		}
+
+		if idx == colEnglish {
+			languages[idx] = germanEntity.LangEnglish
+
+			continue
+		}
+
+		if idx == colThird {
+			parseErrors = append(parseErrors, "Unknown language column: "+name)
+		}
+	}
+
+	return languages, contexts, parseErrors
+}
+
+func parseDictionary(dictionary [][]string, user string) ([]germanEntity.Word, []string) {
 	var (
 		words       = []germanEntity.Word{}
 		parseErrors = []string{}
+		languages   map[int]string
+		contexts    map[int]string
 	)
 
-	for _, rawWord := range dictionary {
-		word, german := createWord(rawWord, user)
+	if len(dictionary) == 0 {
+		return words, parseErrors
+	}
+
+	languages, contexts, parseErrors = parseHeader(dictionary[0])
+
+	for _, rawWord := range dictionary[1:] {
+		word, german := createWord(rawWord, languages, contexts, user)
 
 		words = append(words, word)
 
@@ -89,46 +172,122 @@ func parseDictionary(dictionary [][8]string, user string) ([]germanEntity.Word,
 	return words, parseErrors
 }
 
-func createWord(word [8]string, user string) (germanEntity.Word, string) {
+func getColumn(word []string, idx int) string {
+	if idx >= len(word) {
+		return ""
+	}
+
+	return word[idx]
+}
+
+func getMeanings(word []string, languages map[int]string) map[string]string {
 	var (
-		w                  germanEntity.Word
-		articleOrAuxiliary = word[0]
-		german             = word[1]
-		english            = word[2]
-		third              = word[3]
-		category           = word[4]
-		learned            = word[5]
-		score              = word[6]
-		tags               = word[7]
+		meanings = map[string]string{}
+		indexes  = []int{}
 	)
 
-	if english == "" {
-		return w, german
+	for idx := range languages {
+		indexes = append(indexes, idx)
 	}
 
-	switch category {
-	case "adj":
-		w = germanEntity.NewAdjective(german, english, third, user, learned, score, tags)
-		break
-	case "noun":
-		if germanEntity.NounRegexp.MatchString(german) {
-			w = germanEntity.NewNoun(articleOrAuxiliary, german, english, third, user, learned, score, tags)
+	sort.Ints(indexes)
+
+	for _, idx := range indexes {
+		lang := languages[idx]
+		raw := getColumn(word, idx)
+		if raw == "" {
+			continue
+		}
+
+		if _, ok := meanings[lang]; ok {
+			meanings[lang] += "; " + raw
+		} else {
+			meanings[lang] = raw
+		}
+	}
+
+	return meanings
+}
+
+// getContexts collects the examples and notes of a row, multiple columns of the same kind are joined
+func getContexts(word []string, contexts map[int]string) ([]germanEntity.Example, string) {
+	var (
+		examples = []germanEntity.Example{}
+		notes    = []string{}
+		indexes  = []int{}
+	)
+
+	for idx := range contexts {
+		indexes = append(indexes, idx)
+	}
+
+	sort.Ints(indexes)
+
+	for _, idx := range indexes {
+		raw := strings.Trim(getColumn(word, idx), " ")
+		if raw == "" {
+			continue
 		}
-		break
-	case "verb":
-		if germanEntity.VerbRegexp.MatchString(german) {
-			w = germanEntity.NewVerb(articleOrAuxiliary, german, english, third, user, learned, score, tags)
+
+		if contexts[idx] == headerExamples {
+			examples = append(examples, germanEntity.NewExamples(raw)...)
+		} else {
+			notes = append(notes, raw)
 		}
-		break
-	default:
-		w = germanEntity.NewAny(german, english, third, category, user, learned, score, tags, []string{})
+	}
+
+	return examples, strings.Join(notes, "\n")
+}
+
+func createWord(word []string, languages, contexts map[int]string, user string) (germanEntity.Word, string) {
+	w, german := parseWord(word, languages, user)
+	if w == nil {
+		return w, german
+	}
+
+	examples, notes := getContexts(word, contexts)
+
+	w.SetExamples(examples)
+	w.SetNotes(notes)
+
+	return w, german
+}
+
+func parseWord(word []string, languages map[int]string, user string) (germanEntity.Word, string) {
+	var (
+		w                  germanEntity.Word
+		articleOrAuxiliary = getColumn(word, colArticleOrAuxiliary)
+		german             = getColumn(word, colGerman)
+		meanings           = getMeanings(word, languages)
+		rawCategory        = getColumn(word, colCategory)
+		learned            = getColumn(word, colLearned)
+		score              = getColumn(word, colScore)
+		tags               = getColumn(word, colTags)
+	)
+
+	if len(meanings) == 0 {
+		return w, german
+	}
+
+	category, ok := germanLib.GetCategory(rawCategory)
+	if !ok {
+		w = germanEntity.NewAny(german, meanings, rawCategory, user, learned, score, tags, []string{"Unknown category: " + rawCategory})
+
+		return w, german
+	}
+
+	validationErrors := category.Validate(articleOrAuxiliary, german)
+	if len(validationErrors) == 0 {
+		w = category.Parse(articleOrAuxiliary, german, meanings, category.Name, user, learned, score, tags)
 	}
 
 	if w != nil {
 		return w, ""
 	}
 
-	w = germanEntity.NewAny(german, english, third, category, user, learned, score, tags, []string{"Parsing failed."})
+	validationErrors = append(validationErrors, "Parsing failed.")
+
+	w = germanEntity.NewAny(german, meanings, category.Name, user, learned, score, tags, validationErrors)
 
 	return w, german
 }
@@ -198,7 +357,7 @@ func getServerParserData(r *http.Request) ([]germanEntity.Word, []string) {
  */
 
 func getParserData(rawInput []byte, user string) ([]germanEntity.Word, []string) {
-	var dictionary = [][8]string{}
+	var dictionary = [][]string{}
 
 	err := json.Unmarshal(rawInput, &dictionary)
 	if err != nil {
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	admin "github.com/peteraba/d5/admin/lib"
+	"github.com/peteraba/d5/lib/mongo"
+	"github.com/peteraba/d5/lib/play"
+	"github.com/peteraba/d5/lib/util"
+	"gopkg.in/mgo.v2"
+)
+
+const name = "router"
+const version = "0.1"
+const usage = `
+Router connects frontends and games.
+
+Questions are asked by one of the games enabled for the user, chosen randomly, proportionally to the weights set by the
+user. Answers are sent back to the game that asked the question.
+
+Usage:
+  router [--port=<n>] [--debug]
+  router -h | --help
+  router -v | --version
+
+Options:
+  -p, --port=<n>  port to open [default: 10220]
+  -d, --debug     skip ticks and generate fake data concurrently
+  -v, --version   show version information
+  -h, --help      show help information
+
+API
+  - GET  /game/:user    a question of one of the user's games, query parameters are passed on to the game
+  - POST /answer/:user  answer a question (form: id, answer)
+
+Environment variables:
+  - D5_DB_HOST                  database host or ip
+  - D5_DB_NAME                  database name
+  - D5_COLLECTION_ROUTE         name of route collection
+`
+
+// headerGame is the response header naming the game that asked the question or checked the answer
+const headerGame = "X-D5-Game"
+
+/**
+ * MAIN
+ */
+
+func main() {
+	cliArguments := util.GetCliArguments(usage, name, version)
+	_, port, isDebug := util.GetServerOptions(cliArguments)
+
+	mgoDb := mongo.CreateMgoDbFromEnvs()
+
+	startServer(port, mgoDb, isDebug)
+}
+
+/**
+ * SERVER
+ */
+
+func startServer(port int, mgoDb *mgo.Database, isDebug bool) {
+	if !isDebug {
+		gin.SetMode(gin.ReleaseMode)
+	}
+
+	err := mongo.SetRouteIndexes(play.GetRouteCollection(mgoDb))
+	if err != nil {
+		log.Printf("Setting route indexes failed: %v\n", err)
+	}
+
+	router := gin.Default()
+
+	router.Use(util.MgoDb(mgoDb))
+
+	router.GET("/game/:user", routeQuestion)
+	router.POST("/answer/:user", routeAnswer)
+
+	router.Run(fmt.Sprintf(":%d", port))
+}
+
+func routeQuestion(c *gin.Context) {
+	mgoDb := c.MustGet("mgoDb").(*mgo.Database)
+
+	user, err := admin.FindUserByName(mgoDb, c.Param("user"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, fmt.Sprint(err))
+
+		return
+	}
+
+	games, err := admin.FindGames(mgoDb)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, fmt.Sprint(err))
+
+		return
+	}
+
+	choice, ok := play.Pick(user.GetChoices(games))
+	if !ok {
+		c.JSON(http.StatusNotFound, "No games are enabled for the user.")
+
+		return
+	}
+
+	params := c.Request.URL.Query()
+	if params.Get("lang") == "" && user.Language != "" {
+		params.Set("lang", user.Language)
+	}
+
+	route, body, returnCode, ok := dispatchQuestion(c, choice, user.Username, params)
+	if !ok {
+		return
+	}
+
+	err = play.SaveRoute(play.GetRouteCollection(mgoDb), route)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, fmt.Sprint(err))
+
+		return
+	}
+
+	respond(c, returnCode, choice.Name, body, nil)
+}
+
+// dispatchQuestion asks the game chosen for a question, errors sent by the game are passed on, the route is only
+// returned if the question can be answered
+func dispatchQuestion(c *gin.Context, choice play.Choice, username string, params url.Values) (play.Route, []byte, int, bool) {
+	body, returnCode, err := play.GetQuestion(choice.Url, username, params)
+	if err != nil {
+		respond(c, returnCode, choice.Name, body, err)
+
+		return play.Route{}, nil, returnCode, false
+	}
+
+	questionId, err := play.GetQuestionId(body)
+	if err != nil && play.GetQuestionError(body) != "" {
+		respond(c, returnCode, choice.Name, body, nil)
+
+		return play.Route{}, nil, returnCode, false
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, fmt.Sprintf("Invalid question received from %s.", choice.Name))
+
+		return play.Route{}, nil, http.StatusInternalServerError, false
+	}
+
+	return play.Route{Id: questionId, Game: choice.Name, User: username, CreatedAt: time.Now()}, body, returnCode, true
+}
+
+func routeAnswer(c *gin.Context) {
+	mgoDb := c.MustGet("mgoDb").(*mgo.Database)
+
+	route, err := play.FindRoute(play.GetRouteCollection(mgoDb), c.PostForm("id"))
+	if err != nil || route.User != c.Param("user") {
+		c.JSON(http.StatusNotFound, fmt.Sprintf("Question not found: %s", c.PostForm("id")))
+
+		return
+	}
+
+	g, err := admin.FindGameByName(mgoDb, route.Game)
+	if err != nil {
+		c.JSON(http.StatusNotFound, fmt.Sprint(err))
+
+		return
+	}
+
+	dispatchAnswer(c, route, g.Url)
+}
+
+// dispatchAnswer sends the answer to the game that asked the question
+func dispatchAnswer(c *gin.Context, route play.Route, gameUrl string) {
+	body, returnCode, err := play.PostAnswer(gameUrl, route.User, c.Request.URL.Query(), route.Id, c.PostForm("answer"))
+
+	respond(c, returnCode, route.Game, body, err)
+}
+
+// respond passes the response of a game on to the frontend, errors without a response are sent as JSON
+func respond(c *gin.Context, returnCode int, gameName string, body []byte, err error) {
+	if err != nil && len(body) == 0 {
+		c.JSON(returnCode, fmt.Sprint(err))
+
+		return
+	}
+
+	c.Header(headerGame, gameName)
+	c.Data(returnCode, "application/json; charset=utf-8", body)
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/peteraba/d5/lib/play"
+)
+
+// newGameServer fakes a game, questions are answered with the user and the lang parameter received
+func newGameServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+
+		switch r.URL.Path {
+		case "/game/john_doe":
+			fmt.Fprintf(w, `{"question":"%s","id":"abc"}`, r.URL.Query().Get("lang"))
+			break
+		case "/game/no_words":
+			fmt.Fprint(w, `{"error":"No words found"}`)
+			break
+		case "/game/no_id":
+			fmt.Fprint(w, `{"question":"Haus"}`)
+			break
+		case "/answer/john_doe":
+			fmt.Fprintf(w, `{"score":10,"right":["%s"]}`, r.PostForm.Get("id"))
+			break
+		default:
+			http.Error(w, `{"error":"not found"}`, http.StatusNotFound)
+		}
+	}))
+}
+
+func TestDispatchQuestion(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	server := newGameServer()
+	defer server.Close()
+
+	cases := []struct {
+		user    string
+		code    int
+		body    string
+		isSaved bool
+	}{
+		{"john_doe", http.StatusOK, `{"question":"hu","id":"abc"}`, true},
+		{"no_words", http.StatusOK, `{"error":"No words found"}`, false},
+		{"no_id", http.StatusInternalServerError, `"Invalid question received from Der-die-das."`, false},
+		{"jane_doe", http.StatusNotFound, "{\"error\":\"not found\"}\n", false},
+	}
+
+	for num, testCase := range cases {
+		var (
+			route   play.Route
+			isSaved bool
+		)
+
+		router := gin.New()
+		router.GET("/game/:user", func(c *gin.Context) {
+			choice := play.Choice{Name: "Der-die-das", Url: server.URL, Weight: 1}
+
+			r, body, returnCode, ok := dispatchQuestion(c, choice, c.Param("user"), c.Request.URL.Query())
+			if ok {
+				route, isSaved = r, true
+
+				respond(c, returnCode, choice.Name, body, nil)
+			}
+		})
+
+		recorder := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/game/"+testCase.user+"?lang=hu", nil)
+		router.ServeHTTP(recorder, req)
+
+		if recorder.Code != testCase.code || strings.TrimSpace(recorder.Body.String()) != strings.TrimSpace(testCase.body) {
+			t.Fatalf("Case #%d: Unexpected response: %d, %s", num+1, recorder.Code, recorder.Body.String())
+		}
+
+		if isSaved != testCase.isSaved {
+			t.Fatalf("Case #%d: Expected route saved: %v, got: %v", num+1, testCase.isSaved, isSaved)
+		}
+
+		if isSaved && (route.Id != "abc" || route.Game != "Der-die-das" || route.User != testCase.user || route.CreatedAt.IsZero()) {
+			t.Fatalf("Case #%d: Unexpected route: %v", num+1, route)
+		}
+
+		if testCase.code != http.StatusInternalServerError && recorder.Header().Get(headerGame) != "Der-die-das" {
+			t.Fatalf("Case #%d: Expected game header, got: %v", num+1, recorder.Header())
+		}
+	}
+
+	t.Log(len(cases), "test cases")
+}
+
+func TestDispatchAnswer(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	server := newGameServer()
+	defer server.Close()
+
+	cases := []struct {
+		route   play.Route
+		gameUrl string
+		code    int
+		body    string
+	}{
+		{play.Route{Id: "abc", Game: "Der-die-das", User: "john_doe"}, server.URL, http.StatusOK, `{"score":10,"right":["abc"]}`},
+		{play.Route{Id: "abc", Game: "Der-die-das", User: "jane_doe"}, server.URL, http.StatusNotFound, `{"error":"not found"}`},
+		{play.Route{Id: "abc", Game: "Der-die-das", User: "john_doe"}, "http://127.0.0.1:1", http.StatusServiceUnavailable, ""},
+	}
+
+	for num, testCase := range cases {
+		router := gin.New()
+		router.POST("/answer/:user", func(c *gin.Context) {
+			dispatchAnswer(c, testCase.route, testCase.gameUrl)
+		})
+
+		data := url.Values{"id": []string{"abc"}, "answer": []string{"der"}}
+
+		recorder := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/answer/"+testCase.route.User, strings.NewReader(data.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		router.ServeHTTP(recorder, req)
+
+		if recorder.Code != testCase.code {
+			t.Fatalf("Case #%d: Expected status %d, got: %d, %s", num+1, testCase.code, recorder.Code, recorder.Body.String())
+		}
+
+		if testCase.body != "" && strings.TrimSpace(recorder.Body.String()) != testCase.body {
+			t.Fatalf("Case #%d: Unexpected response: %s", num+1, recorder.Body.String())
+		}
+	}
+
+	t.Log(len(cases), "test cases")
+}
@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/peteraba/d5/lib/general"
 	"github.com/peteraba/d5/lib/german/entity"
 	"github.com/peteraba/d5/lib/mongo"
 	"github.com/peteraba/d5/lib/repository"
@@ -26,20 +27,26 @@ Scorer supports CLI and Server mode.
 In CLI mode it expects input data on standard input as JSON, in server mode as a standard form.
 
 Usage:
-  scorer [--server] [--port=<n>] [--debug]
+  scorer [--server] [--port=<n>] [--debug] [--scheduler=<s>]
   scorer -h | --help
   scorer -v | --version
 
 Options:
-  -s, --server    run in server mode
-  -p, --port=<n>  port to open (server mode only) [default: 10230]
-  -d, --debug     skip ticks and generate fake data concurrently
-  -v, --version   show version information
-  -h, --help      show help information
+  -s, --server         run in server mode
+  -p, --port=<n>       port to open (server mode only) [default: 10230]
+  -d, --debug          skip ticks and generate fake data concurrently
+  -r, --scheduler=<s>  spaced repetition algorithm: sm2 or fsrs [default: sm2]
+  -v, --version        show version information
+  -h, --help           show help information
 
 Accepted input data:
-  - wordId  Word id to find
-  - score   Score to associate
+  - wordId        Word id to find
+  - score         Score to associate
+  - game          Name of the game the score was given by (optional)
+  - questionType  Type of the question asked (optional)
+  - answer        Answer given by the user (optional)
+  - right         Accepted answer, may be repeated (optional)
+  - latency       Time taken to answer in milliseconds (optional)
 
 Environment variables:
   - D5_DB_HOST                  database host or ip
@@ -55,23 +62,28 @@ Environment variables:
  */
 
 func main() {
-	isServer, port, isDebug := util.GetServerOptions(util.GetCliArguments(usage, name, version))
+	cliArguments := util.GetCliArguments(usage, name, version)
+	isServer, port, isDebug := util.GetServerOptions(cliArguments)
+
+	algorithm, _ := cliArguments["--scheduler"].(string)
+	scheduler := general.GetScheduler(algorithm)
 
 	mgoDb := mongo.CreateMgoDbFromEnvs()
 
 	if isServer {
-		startServer(port, mgoDb, isDebug)
+		startServer(port, mgoDb, scheduler, isDebug)
 		return
 	}
 
-	serveCli(mgoDb, isDebug)
+	serveCli(mgoDb, scheduler, isDebug)
 }
 
 /**
  * DOMAIN
  */
 
-func getScoreResponse(repo repository.QueryRepo, collectionName string, wordId string, score int) (bool, error) {
+// getScoreResponse saves the score of a word and reschedules its next review
+func getScoreResponse(repo repository.QueryRepo, scheduler general.Scheduler, collectionName string, wordId string, score *general.Score) (bool, error) {
 	var (
 		err      error
 		word     entity.Word
@@ -88,32 +100,33 @@ func getScoreResponse(repo repository.QueryRepo, collectionName string, wordId s
 		return false, err
 	}
 
-	word.NewScore(score)
+	word.AddScore(score)
+	word.SetSchedule(scheduler.Review(word.GetSchedule(), score.Result, score.LearnedAt))
 
 	err = repo.UpdateWord(collectionName, *objectId, word)
 
 	return err == nil, err
 }
 
-func saveScore(mgoDb *mgo.Database, collectionName string, wordId string, score int) (bool, error) {
+func saveScore(mgoDb *mgo.Database, scheduler general.Scheduler, collectionName string, wordId string, score *general.Score) (bool, error) {
 	repo := repository.CreateRepo(mgoDb)
 
-	return getScoreResponse(repo, collectionName, wordId, score)
+	return getScoreResponse(repo, scheduler, collectionName, wordId, score)
 }
 
 /**
  * CLI
  */
 
-func serveCli(mgoDb *mgo.Database, isDebug bool) {
-	result, err := cliHandler(mgoDb, isDebug)
+func serveCli(mgoDb *mgo.Database, scheduler general.Scheduler, isDebug bool) {
+	result, err := cliHandler(mgoDb, scheduler, isDebug)
 
 	util.LogFatalErr(err, isDebug)
 
 	fmt.Print(result)
 }
 
-func cliHandler(mgoDb *mgo.Database, isDebug bool) (interface{}, error) {
+func cliHandler(mgoDb *mgo.Database, scheduler general.Scheduler, isDebug bool) (interface{}, error) {
 	stdInput, err := util.ReadStdInput()
 	if err != nil {
 		return nil, err
@@ -124,7 +137,7 @@ func cliHandler(mgoDb *mgo.Database, isDebug bool) (interface{}, error) {
 		return nil, err
 	}
 
-	data, err := saveScore(mgoDb, collectionName, wordId, score)
+	data, err := saveScore(mgoDb, scheduler, collectionName, wordId, score)
 	if err != nil {
 		return nil, err
 	}
@@ -132,84 +145,107 @@ func cliHandler(mgoDb *mgo.Database, isDebug bool) (interface{}, error) {
 	return util.DataToJson(data, isDebug)
 }
 
-func getCliScoreData(stdInput []byte) (string, int, string, error) {
+func getCliScoreData(stdInput []byte) (string, *general.Score, string, error) {
 	input := strings.Trim(string(stdInput), "\n\t ")
 	values, err := url.ParseQuery(input)
 
 	if err != nil {
-		return "", 0, "", err
+		return "", nil, "", err
 	}
 
-	wordId := values.Get("wordId")
-	rawScore := values.Get("score")
-
-	return getScoreData(wordId, rawScore)
+	return getScoreData(values)
 }
 
 /**
  * SERVER
  */
 
-func startServer(port int, mgoDb *mgo.Database, isDebug bool) {
+func startServer(port int, mgoDb *mgo.Database, scheduler general.Scheduler, isDebug bool) {
 	s := server.MakeServer(port, mgoDb, isDebug)
 
-	s.AddHandler("/", scoreHandle, server.PostOnly)
+	s.AddHandler("/", makeScoreHandle(scheduler), server.PostOnly)
 
 	s.Start()
 }
 
-func scoreHandle(w http.ResponseWriter, r *http.Request, mgoDb *mgo.Database, isDebug bool) error {
-	wordId, score, collectionName, err := getServerScoreData(r)
-	if err != nil {
-		return err
-	}
+func makeScoreHandle(scheduler general.Scheduler) func(w http.ResponseWriter, r *http.Request, mgoDb *mgo.Database, isDebug bool) error {
+	return func(w http.ResponseWriter, r *http.Request, mgoDb *mgo.Database, isDebug bool) error {
+		wordId, score, collectionName, err := getServerScoreData(r)
+		if err != nil {
+			return err
+		}
 
-	data, err := saveScore(mgoDb, collectionName, wordId, score)
-	if err != nil {
-		return err
-	}
+		data, err := saveScore(mgoDb, scheduler, collectionName, wordId, score)
+		if err != nil {
+			return err
+		}
 
-	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 
-	json.NewEncoder(w).Encode(data)
+		json.NewEncoder(w).Encode(data)
 
-	return nil
+		return nil
+	}
 }
 
-func getServerScoreData(r *http.Request) (string, int, string, error) {
-	wordId := r.FormValue("wordId")
-	score := r.FormValue("score")
+func getServerScoreData(r *http.Request) (string, *general.Score, string, error) {
+	err := r.ParseForm()
+	if err != nil {
+		return "", nil, "", err
+	}
 
-	return getScoreData(wordId, score)
+	return getScoreData(r.Form)
 }
 
 /**
  * INPUT PARSING
  */
 
-func getScoreData(wordId, rawScore string) (string, int, string, error) {
+func getScoreData(values url.Values) (string, *general.Score, string, error) {
 	collectionName := mongo.ParseDataCollection()
 
-	return filterData(wordId, rawScore, collectionName)
+	return filterData(values, collectionName)
 }
 
-func filterData(wordId, rawScore, collectionName string) (string, int, string, error) {
+func filterData(values url.Values, collectionName string) (string, *general.Score, string, error) {
+	var (
+		wordId   = values.Get("wordId")
+		rawScore = values.Get("score")
+	)
+
 	if wordId == "" {
-		return "", 0, "", errors.New("Word id was not posted.")
+		return "", nil, "", errors.New("Word id was not posted.")
 	}
 
 	if rawScore == "" {
-		return "", 0, "", errors.New("Score was not posted.")
+		return "", nil, "", errors.New("Score was not posted.")
 	}
 
 	score64, err := strconv.ParseInt(rawScore, 10, 0)
 	if err != nil {
-		return "", 0, "", errors.New("Score is not a valid integer")
+		return "", nil, "", errors.New("Score is not a valid integer")
 	}
 
 	if score64 < -10 || score64 > 10 {
-		return "", 0, "", errors.New("Score is not between -10 and 10")
+		return "", nil, "", errors.New("Score is not between -10 and 10")
+	}
+
+	score, err := general.NewScore(int(score64))
+	if err != nil {
+		return "", nil, "", err
+	}
+
+	score.Game = values.Get("game")
+	score.QuestionType = values.Get("questionType")
+	score.Answer = values.Get("answer")
+	score.Right = values["right"]
+
+	if rawLatency := values.Get("latency"); rawLatency != "" {
+		score.Latency, err = strconv.ParseInt(rawLatency, 10, 64)
+		if err != nil || score.Latency < 0 {
+			return "", nil, "", errors.New("Latency is not a valid number of milliseconds")
+		}
 	}
 
-	return wordId, int(score64), collectionName, nil
+	return wordId, score, collectionName, nil
 }
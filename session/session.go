@@ -0,0 +1,367 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	admin "github.com/peteraba/d5/admin/lib"
+	game "github.com/peteraba/d5/game/lib"
+	"github.com/peteraba/d5/lib/mongo"
+	"github.com/peteraba/d5/lib/play"
+	"github.com/peteraba/d5/lib/repository"
+	"github.com/peteraba/d5/lib/session"
+	"github.com/peteraba/d5/lib/util"
+	"gopkg.in/mgo.v2"
+)
+
+const name = "session"
+const version = "0.1"
+const usage = `
+Session serves timed study sessions, mixing questions of the games enabled by the user.
+
+A session is a focus period followed by a break. Questions are only asked during focus, the next session can only be
+started after the break. Games are chosen randomly, proportionally to the weights set by the user.
+
+Usage:
+  session [--port=<n>] [--debug] [--focus=<m>] [--break=<m>]
+  session -h | --help
+  session -v | --version
+
+Options:
+  -p, --port=<n>   port to open [default: 10240]
+  -d, --debug      skip ticks and generate fake data concurrently
+  -f, --focus=<m>  default length of focus in minutes [default: 25]
+  -b, --break=<m>  default length of breaks in minutes [default: 5]
+  -v, --version    show version information
+  -h, --help       show help information
+
+API
+  - POST /session               start a session (form: user, focus and break in minutes, optional)
+  - GET  /session/:id           the session and its summary
+  - GET  /session/:id/question  a question of one of the user's games
+  - POST /session/:id/answer    answer a question of the session (form: id, answer)
+  - GET  /history/:user         the latest sessions of the user and their summaries (limit parameter, 20 by default)
+
+Environment variables:
+  - D5_DB_HOST                  database host or ip
+  - D5_DB_NAME                  database name
+  - D5_GAME_TYPE                game type
+  - D5_COLLECTION_DATA_GENERAL  name of general collection
+  - D5_COLLECTION_DATA_GERMAN   name of german collection
+  - D5_COLLECTION_RESULT        name of result collection
+  - D5_COLLECTION_SESSION       name of session collection
+`
+
+const defaultHistoryLimit = 20
+
+/**
+ * MAIN
+ */
+
+func main() {
+	cliArguments := util.GetCliArguments(usage, name, version)
+	_, port, isDebug := util.GetServerOptions(cliArguments)
+
+	rawFocus, _ := cliArguments["--focus"].(string)
+	rawBreak, _ := cliArguments["--break"].(string)
+	focusMinutes, _ := strconv.Atoi(rawFocus)
+	breakMinutes, _ := strconv.Atoi(rawBreak)
+
+	mgoDb := mongo.CreateMgoDbFromEnvs()
+
+	startServer(port, mgoDb, focusMinutes, breakMinutes, isDebug)
+}
+
+/**
+ * SERVER
+ */
+
+func startServer(port int, mgoDb *mgo.Database, focusMinutes, breakMinutes int, isDebug bool) {
+	if !isDebug {
+		gin.SetMode(gin.ReleaseMode)
+	}
+
+	router := gin.Default()
+
+	router.Use(util.MgoDb(mgoDb))
+
+	router.POST("/session", makeStartHandle(focusMinutes, breakMinutes))
+	router.GET("/session/:id", readSession)
+	router.GET("/session/:id/question", askQuestion)
+	router.POST("/session/:id/answer", checkAnswer)
+	router.GET("/history/:user", readHistory)
+
+	router.Run(fmt.Sprintf(":%d", port))
+}
+
+type sessionResponse struct {
+	Session session.Session `json:"session"`
+	Summary session.Summary `json:"summary"`
+}
+
+func newSessionResponse(s session.Session, now time.Time) sessionResponse {
+	return sessionResponse{s, s.Summarize(now)}
+}
+
+func makeStartHandle(focusMinutes, breakMinutes int) func(c *gin.Context) {
+	return func(c *gin.Context) {
+		mgoDb := c.MustGet("mgoDb").(*mgo.Database)
+		now := time.Now()
+
+		user, err := admin.FindUserByName(mgoDb, c.PostForm("user"))
+		if err != nil {
+			c.JSON(http.StatusNotFound, fmt.Sprint(err))
+
+			return
+		}
+
+		history, err := session.FindHistory(session.GetCollection(mgoDb), user.Username, 1)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, fmt.Sprint(err))
+
+			return
+		}
+
+		if len(history) > 0 && !session.CanStart(&history[0], now) {
+			c.JSON(http.StatusConflict, fmt.Sprintf("Take a break, the next session can be started at %s.", history[0].GetBreakEnd().Format(time.RFC3339)))
+
+			return
+		}
+
+		s := session.NewSession(user.Username, user.Language, getMinutes(c, "focus", focusMinutes), getMinutes(c, "break", breakMinutes), now)
+
+		err = session.Save(session.GetCollection(mgoDb), s)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, fmt.Sprint(err))
+
+			return
+		}
+
+		c.JSON(http.StatusOK, newSessionResponse(s, now))
+	}
+}
+
+// getMinutes returns a positive length posted in minutes, the default one otherwise
+func getMinutes(c *gin.Context, key string, defaultMinutes int) int {
+	minutes, err := strconv.Atoi(c.PostForm(key))
+	if err != nil || minutes <= 0 {
+		return defaultMinutes
+	}
+
+	return minutes
+}
+
+func readSession(c *gin.Context) {
+	mgoDb := c.MustGet("mgoDb").(*mgo.Database)
+
+	s, err := session.Find(session.GetCollection(mgoDb), c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, fmt.Sprint(err))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, newSessionResponse(s, time.Now()))
+}
+
+func askQuestion(c *gin.Context) {
+	mgoDb := c.MustGet("mgoDb").(*mgo.Database)
+	now := time.Now()
+
+	s, err := session.Find(session.GetCollection(mgoDb), c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, fmt.Sprint(err))
+
+		return
+	}
+
+	if s.GetState(now) != session.StateFocus {
+		c.JSON(http.StatusConflict, newSessionResponse(s, now))
+
+		return
+	}
+
+	choices, err := getChoices(mgoDb, s.User)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, fmt.Sprint(err))
+
+		return
+	}
+
+	choice, ok := play.Pick(choices)
+	if !ok {
+		c.JSON(http.StatusNotFound, "No games are enabled for the user.")
+
+		return
+	}
+
+	body, returnCode, err := play.GetQuestion(choice.Url, s.User, url.Values{"lang": []string{s.Language}})
+	if err != nil {
+		c.JSON(returnCode, fmt.Sprint(err))
+
+		return
+	}
+
+	questionId, err := play.GetQuestionId(body)
+	if err != nil && play.GetQuestionError(body) != "" {
+		// errors sent by the game instead of a question are passed on, they are not added to the session
+		c.JSON(returnCode, gin.H{"game": choice.Name, "question": json.RawMessage(body), "focusEnd": s.GetFocusEnd()})
+
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, fmt.Sprintf("Invalid question received from %s.", choice.Name))
+
+		return
+	}
+
+	err = s.AddQuestion(choice.Name, questionId, now)
+	if err == nil {
+		err = session.Save(session.GetCollection(mgoDb), s)
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, fmt.Sprint(err))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"game": choice.Name, "question": json.RawMessage(body), "focusEnd": s.GetFocusEnd()})
+}
+
+func checkAnswer(c *gin.Context) {
+	var (
+		mgoDb  = c.MustGet("mgoDb").(*mgo.Database)
+		result struct {
+			Score int `json:"score"`
+		}
+	)
+
+	s, err := session.Find(session.GetCollection(mgoDb), c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, fmt.Sprint(err))
+
+		return
+	}
+
+	question, ok := s.FindQuestion(c.PostForm("id"))
+	if !ok {
+		c.JSON(http.StatusBadRequest, fmt.Sprintf("Question was not asked in this session: %s", c.PostForm("id")))
+
+		return
+	}
+
+	g, err := admin.FindGameByName(mgoDb, question.Game)
+	if err != nil {
+		c.JSON(http.StatusNotFound, fmt.Sprint(err))
+
+		return
+	}
+
+	// words have to be checked before the answer is scored
+	wordIds, newWordIds := getWords(mgoDb, question.Id)
+
+	body, returnCode, err := play.PostAnswer(g.Url, s.User, url.Values{"lang": []string{s.Language}}, question.Id, c.PostForm("answer"))
+	if err != nil {
+		c.JSON(returnCode, fmt.Sprint(err))
+
+		return
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, fmt.Sprintf("Invalid result received from %s.", question.Game))
+
+		return
+	}
+
+	now := time.Now()
+
+	err = s.AddAnswer(session.Answer{QuestionId: question.Id, Answer: c.PostForm("answer"), Score: result.Score, WordIds: wordIds, NewWordIds: newWordIds, AnsweredAt: now})
+	if err == nil {
+		err = session.Save(session.GetCollection(mgoDb), s)
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, fmt.Sprint(err))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"game": question.Game, "result": json.RawMessage(body), "summary": s.Summarize(now)})
+}
+
+func readHistory(c *gin.Context) {
+	mgoDb := c.MustGet("mgoDb").(*mgo.Database)
+	now := time.Now()
+
+	limit, err := strconv.Atoi(c.Query("limit"))
+	if err != nil || limit <= 0 {
+		limit = defaultHistoryLimit
+	}
+
+	history, err := session.FindHistory(session.GetCollection(mgoDb), c.Param("user"), limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, fmt.Sprint(err))
+
+		return
+	}
+
+	responses := []sessionResponse{}
+	for _, s := range history {
+		responses = append(responses, newSessionResponse(s, now))
+	}
+
+	c.JSON(http.StatusOK, responses)
+}
+
+/**
+ * DOMAIN
+ */
+
+// getChoices returns the games enabled for the user with their weights
+func getChoices(mgoDb *mgo.Database, username string) ([]play.Choice, error) {
+	user, err := admin.FindUserByName(mgoDb, username)
+	if err != nil {
+		return nil, err
+	}
+
+	games, err := admin.FindGames(mgoDb)
+	if err != nil {
+		return nil, err
+	}
+
+	return user.GetChoices(games), nil
+}
+
+// getWords returns the ids of the words asked in a question and the ones never scored before
+func getWords(mgoDb *mgo.Database, questionId string) ([]string, []string) {
+	var newWordIds []string
+
+	tracker, _, err := game.FindAnswer(questionId, mgoDb.C(mongo.ParseResultCollection()))
+	if err != nil {
+		return nil, nil
+	}
+
+	repo := repository.CreateRepo(mgoDb)
+
+	for _, wordId := range tracker.WordIds {
+		objectId := util.HexToObjectId(wordId)
+		if objectId == nil {
+			continue
+		}
+
+		word, err := repo.FetchWord(mongo.ParseDataCollection(), *objectId)
+		if err == nil && word != nil && len(word.GetScores()) == 0 {
+			newWordIds = append(newWordIds, wordId)
+		}
+	}
+
+	return tracker.WordIds, newWordIds
+}